@@ -20,7 +20,10 @@ var AuthKey = "authorization"
 
 // JwtInterceptor struct
 type JwtInterceptor struct {
-	jwtManager *JwtManager
+	jwtManager     *JwtManager
+	serviceManager *ServiceTokenManager
+	audience       string
+	serviceMethods map[string]bool
 }
 
 // NewJwtInterceptor create new auth interceptor
@@ -30,14 +33,25 @@ func NewJwtInterceptor(jwtManager *JwtManager) *JwtInterceptor {
 	}
 }
 
+// AllowServices authorize the services tokens issued for audience on the
+// methods, full method names like "/v1.FollowService/ListFollow".
+func (i *JwtInterceptor) AllowServices(manager *ServiceTokenManager, audience string, methods ...string) *JwtInterceptor {
+	i.serviceManager = manager
+	i.audience = audience
+	i.serviceMethods = map[string]bool{}
+	for _, method := range methods {
+		i.serviceMethods[method] = true
+	}
+	return i
+}
+
 // Unary check if there is a token in the request context
 func (i *JwtInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		claims, err := i.isAuthorized(ctx)
+		ctx, err := i.authorize(ctx, info.FullMethod)
 		if err != nil {
 			return nil, err
 		}
-		ctx = context.WithValue(ctx, ClaimKey("claims"), claims)
 		return handler(ctx, req)
 	}
 }
@@ -49,13 +63,12 @@ func (i *JwtInterceptor) Stream() grpc.StreamServerInterceptor {
 		info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler,
 	) error {
-		ctx := ss.Context()
-		claims, err := i.isAuthorized(ctx)
+		ctx, err := i.authorize(ss.Context(), info.FullMethod)
 		if err != nil {
 			return err
 		}
 		newStream := grpc_middleware.WrapServerStream(ss)
-		newStream.WrappedContext = context.WithValue(ctx, ClaimKey("claims"), claims)
+		newStream.WrappedContext = ctx
 		return handler(srv, newStream)
 	}
 }
@@ -69,6 +82,37 @@ func GetUserInfosFromContext(ctx context.Context) (*UserClaims, error) {
 	return userInfos, nil
 }
 
+// authorize add the caller claims to the context, a request without user
+// token may be authorized with a service token.
+func (i *JwtInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md[AuthKey]) == 0 && len(md[ServiceKey]) > 0 {
+		claims, err := i.isServiceAuthorized(md[ServiceKey][0], method)
+		if err != nil {
+			return nil, err
+		}
+		return context.WithValue(ctx, ClaimKey("service"), claims), nil
+	}
+
+	claims, err := i.isAuthorized(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, ClaimKey("claims"), claims), nil
+}
+
+func (i *JwtInterceptor) isServiceAuthorized(serviceToken, method string) (*ServiceClaims, error) {
+	if i.serviceManager == nil || !i.serviceMethods[method] {
+		return nil, status.Errorf(codes.PermissionDenied, "Method not allowed to services")
+	}
+
+	claims, err := i.serviceManager.Verify(serviceToken, i.audience)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "service token not valid")
+	}
+	return claims, nil
+}
+
 func (i *JwtInterceptor) isAuthorized(ctx context.Context) (*UserClaims, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -0,0 +1,74 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+)
+
+func TestServiceToken(t *testing.T) {
+	manager := auth.NewServiceTokenManager("service-secret", time.Minute)
+
+	token, err := manager.Generate("timeline", "follow")
+	require.NoError(t, err)
+
+	claims, err := manager.Verify(token, "follow")
+	require.NoError(t, err)
+	require.Equal(t, "timeline", claims.Service)
+
+	_, err = manager.Verify(token, "tweet")
+	require.Error(t, err)
+
+	other := auth.NewServiceTokenManager("other-secret", time.Minute)
+	_, err = other.Verify(token, "follow")
+	require.Error(t, err)
+
+	// user tokens are not service tokens.
+	jwtManager := auth.NewJwtManager("service-secret", time.Minute, time.Minute)
+	userToken, err := jwtManager.GenerateAccessToken(&pb.User{Id: 1, Username: "user"})
+	require.NoError(t, err)
+	_, err = manager.Verify(userToken, "follow")
+	require.Error(t, err)
+}
+
+func TestServiceInterceptor(t *testing.T) {
+	jwtManager := auth.NewJwtManager("secret", time.Minute, time.Minute)
+	manager := auth.NewServiceTokenManager("service-secret", time.Minute)
+	interceptor := auth.NewJwtInterceptor(jwtManager).
+		AllowServices(manager, "follow", "/v1.FollowService/ListFollow")
+
+	creds := auth.NewServiceCredentials(manager, "timeline", "follow")
+	md, err := creds.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return auth.GetServiceFromContext(ctx)
+	}
+	call := func(method string) (interface{}, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.New(md))
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		return interceptor.Unary()(ctx, nil, info, handler)
+	}
+
+	res, err := call("/v1.FollowService/ListFollow")
+	require.NoError(t, err)
+	require.Equal(t, "timeline", res.(*auth.ServiceClaims).Service)
+
+	_, err = call("/v1.FollowService/ToggleFollow")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the token is only valid for its audience.
+	md, err = auth.NewServiceCredentials(manager, "timeline", "tweet").GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	_, err = call("/v1.FollowService/ListFollow")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -42,7 +42,7 @@ func (s *PostgresAuthStore) Create(ctx context.Context, username, hashPassword s
 
 	stmt, err := tx.PrepareContext(
 		ctx,
-		"SELECT EXISTS(SELECT 1 FROM users WHERE username=$1);",
+		"SELECT EXISTS(SELECT 1 FROM users WHERE lower(username)=lower($1));",
 	)
 
 	if err != nil {
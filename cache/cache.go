@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"strconv"
+
+	"github.com/idirall22/twee/pb"
+)
+
+// Cache key value cache, entries expire and the least recently used ones
+// are evicted when the cache is full.
+type Cache interface {
+	// Get a value, false when missing or expired.
+	Get(key string) (interface{}, bool)
+	// Set a value, tags group entries invalidated together.
+	Set(key string, value interface{}, tags ...string)
+	// Delete entries by key.
+	Delete(keys ...string)
+	// Invalidate delete the entries with one of the tags.
+	Invalidate(tags ...string)
+	// Stats return the cache statistics.
+	Stats() Stats
+}
+
+// Stats cache statistics.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Len       int
+}
+
+// HitRate ratio of lookups that found a value, 0 without lookups.
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// UserTag tag of the entries computed from a user follows or profile.
+func UserTag(userID int64) string {
+	return "user:" + strconv.FormatInt(userID, 10)
+}
+
+// AuthorTag tag of the entries listing a user tweets.
+func AuthorTag(userID int64) string {
+	return "author:" + strconv.FormatInt(userID, 10)
+}
+
+// TweetEventTags return the tags invalidated by a tweet event, every event
+// changes the author tweets or the liked tweet counts, edits and deletions
+// may also change the author pinned tweet.
+func TweetEventTags(e *pb.TweetEvent) []string {
+	tags := []string{AuthorTag(e.UserId)}
+	if e.TweetUserId != 0 && e.TweetUserId != e.UserId {
+		tags = append(tags, AuthorTag(e.TweetUserId))
+	}
+	if e.Action == pb.Action_UPDATED || e.Action == pb.Action_DELETED {
+		tags = append(tags, UserTag(e.UserId))
+	}
+	return tags
+}
+
+// FollowEventTags return the tags invalidated by a follow event, the
+// follower follows and the counts of both users change.
+func FollowEventTags(e *pb.FollowEvent) []string {
+	return []string{UserTag(e.Follower), UserTag(e.Followee)}
+}
+
+// Watch invalidate the entries affected by tweet and follow events until
+// done is closed or both channels are closed, a nil channel is ignored.
+func Watch(c Cache, tweets <-chan *pb.TweetEvent, follows <-chan *pb.FollowEvent, done <-chan struct{}) {
+	for tweets != nil || follows != nil {
+		select {
+		case <-done:
+			return
+		case e, ok := <-tweets:
+			if !ok {
+				tweets = nil
+				continue
+			}
+			c.Invalidate(TweetEventTags(e)...)
+		case e, ok := <-follows:
+			if !ok {
+				follows = nil
+				continue
+			}
+			c.Invalidate(FollowEventTags(e)...)
+		}
+	}
+}
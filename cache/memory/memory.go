@@ -0,0 +1,140 @@
+package memorycache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/idirall22/twee/cache"
+)
+
+// entry a cached value.
+type entry struct {
+	key       string
+	value     interface{}
+	tags      []string
+	expiresAt time.Time
+}
+
+// MemoryCache in memory cache bounded in size, entries expire after ttl.
+type MemoryCache struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	lru   *list.List
+	items map[string]*list.Element
+	tags  map[string]map[string]bool
+	stats cache.Stats
+}
+
+// NewMemoryCache create new in memory cache of at most size entries.
+func NewMemoryCache(size int, ttl time.Duration) *MemoryCache {
+	return &MemoryCache{
+		size:  size,
+		ttl:   ttl,
+		lru:   list.New(),
+		items: map[string]*list.Element{},
+		tags:  map[string]map[string]bool{},
+	}
+}
+
+// Get a value, an expired entry is removed.
+func (m *MemoryCache) Get(key string) (interface{}, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.items[key]
+	if !ok {
+		m.stats.Misses++
+		return nil, false
+	}
+
+	e := el.Value.(*entry)
+	if time.Now().After(e.expiresAt) {
+		m.remove(el)
+		m.stats.Evictions++
+		m.stats.Misses++
+		return nil, false
+	}
+
+	m.lru.MoveToFront(el)
+	m.stats.Hits++
+	return e.value, true
+}
+
+// Set a value, the least recently used entry is evicted when the cache
+// is full.
+func (m *MemoryCache) Set(key string, value interface{}, tags ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.items[key]; ok {
+		m.remove(el)
+	}
+
+	el := m.lru.PushFront(&entry{
+		key:       key,
+		value:     value,
+		tags:      tags,
+		expiresAt: time.Now().Add(m.ttl),
+	})
+	m.items[key] = el
+	for _, tag := range tags {
+		if m.tags[tag] == nil {
+			m.tags[tag] = map[string]bool{}
+		}
+		m.tags[tag][key] = true
+	}
+
+	for m.lru.Len() > m.size {
+		m.remove(m.lru.Back())
+		m.stats.Evictions++
+	}
+}
+
+// Delete entries by key.
+func (m *MemoryCache) Delete(keys ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, key := range keys {
+		if el, ok := m.items[key]; ok {
+			m.remove(el)
+		}
+	}
+}
+
+// Invalidate delete the entries with one of the tags.
+func (m *MemoryCache) Invalidate(tags ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, tag := range tags {
+		for key := range m.tags[tag] {
+			m.remove(m.items[key])
+		}
+	}
+}
+
+// Stats return the cache statistics.
+func (m *MemoryCache) Stats() cache.Stats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := m.stats
+	stats.Len = m.lru.Len()
+	return stats
+}
+
+// remove an entry and its tags.
+func (m *MemoryCache) remove(el *list.Element) {
+	e := m.lru.Remove(el).(*entry)
+	delete(m.items, e.key)
+
+	for _, tag := range e.tags {
+		delete(m.tags[tag], e.key)
+		if len(m.tags[tag]) == 0 {
+			delete(m.tags, tag)
+		}
+	}
+}
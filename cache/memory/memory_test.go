@@ -0,0 +1,88 @@
+package memorycache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/cache"
+	memorycache "github.com/idirall22/twee/cache/memory"
+	"github.com/idirall22/twee/pb"
+)
+
+func TestMemoryCache(t *testing.T) {
+	c := memorycache.NewMemoryCache(2, time.Minute)
+
+	_, ok := c.Get("a")
+	require.False(t, ok)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	v, ok := c.Get("a")
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+
+	// b is the least recently used entry.
+	c.Set("c", 3)
+	_, ok = c.Get("b")
+	require.False(t, ok)
+	_, ok = c.Get("a")
+	require.True(t, ok)
+
+	c.Delete("a")
+	_, ok = c.Get("a")
+	require.False(t, ok)
+
+	stats := c.Stats()
+	require.Equal(t, uint64(2), stats.Hits)
+	require.Equal(t, uint64(3), stats.Misses)
+	require.Equal(t, uint64(1), stats.Evictions)
+	require.Equal(t, 1, stats.Len)
+	require.Equal(t, 0.4, stats.HitRate())
+}
+
+func TestMemoryCacheTTL(t *testing.T) {
+	c := memorycache.NewMemoryCache(10, time.Millisecond*20)
+
+	c.Set("a", 1)
+	_, ok := c.Get("a")
+	require.True(t, ok)
+
+	time.Sleep(time.Millisecond * 40)
+	_, ok = c.Get("a")
+	require.False(t, ok)
+	require.Equal(t, 0, c.Stats().Len)
+}
+
+func TestWatch(t *testing.T) {
+	c := memorycache.NewMemoryCache(10, time.Minute)
+	c.Set("follows:1", 1, cache.UserTag(1))
+	c.Set("profile:alice:0", 1, cache.UserTag(1))
+	c.Set("profile:bob:0", 2, cache.UserTag(2))
+	c.Set("profile:carol:0", 3, cache.UserTag(3))
+	c.Set("timeline:carol", 3, cache.AuthorTag(3))
+	c.Set("timeline:dave", 4, cache.AuthorTag(4))
+
+	tweets := make(chan *pb.TweetEvent, 2)
+	follows := make(chan *pb.FollowEvent, 1)
+
+	// created tweets do not change follows nor profiles, only the author
+	// tweets.
+	tweets <- &pb.TweetEvent{Action: pb.Action_CREATED, UserId: 3}
+	tweets <- &pb.TweetEvent{Action: pb.Action_DELETED, UserId: 2}
+	follows <- &pb.FollowEvent{Action: pb.Action_CREATED, Follower: 1, Followee: 4}
+	close(tweets)
+	close(follows)
+
+	cache.Watch(c, tweets, follows, nil)
+
+	for _, key := range []string{"follows:1", "profile:alice:0", "profile:bob:0", "timeline:carol"} {
+		_, ok := c.Get(key)
+		require.False(t, ok, key)
+	}
+	for _, key := range []string{"profile:carol:0", "timeline:dave"} {
+		_, ok := c.Get(key)
+		require.True(t, ok, key)
+	}
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PageCursor position of a tweet in a newest first listing, tweets are
+// ordered by creation date then id both descending.
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// Encode return an opaque page token.
+func (c *PageCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodePageCursor decode a page token, an empty token return a nil cursor.
+func DecodePageCursor(token string) (*PageCursor, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid page token: %v", err)
+	}
+
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid page token")
+	}
+
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid page token date: %v", err)
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid page token id: %v", err)
+	}
+
+	return &PageCursor{CreatedAt: time.Unix(0, nanos), ID: id}, nil
+}
+
+// Page newest first page of tweets.
+type Page struct {
+	// After only tweets older than the cursor are listed, ignored if nil.
+	After *PageCursor
+	// MaxID only tweets with a lower or equal id are listed, ignored if zero.
+	MaxID int64
+	// SinceID only tweets with a greater id are listed, ignored if zero.
+	SinceID int64
+	// Limit maximum number of tweets.
+	Limit int32
+}
+
+// Conditions return the sql conditions selecting the page tweets aliased
+// by alias, arg binds a value and return its placeholder.
+func (p *Page) Conditions(alias string, arg func(v interface{}) string) []string {
+	conds := []string{}
+	if p.After != nil {
+		conds = append(conds, fmt.Sprintf("(%s.created_at, %s.id) < (%s, %s)",
+			alias, alias, arg(p.After.CreatedAt), arg(p.After.ID)))
+	}
+	if p.MaxID != 0 {
+		conds = append(conds, fmt.Sprintf("%s.id <= %s", alias, arg(p.MaxID)))
+	}
+	if p.SinceID != 0 {
+		conds = append(conds, fmt.Sprintf("%s.id > %s", alias, arg(p.SinceID)))
+	}
+	return conds
+}
@@ -0,0 +1,28 @@
+package common
+
+// TweetVisibleTo return a sql condition true when the tweet aliased by
+// alias can be seen by the user id bound to viewer, ex:
+// TweetVisibleTo("t", "$2").
+func TweetVisibleTo(alias, viewer string) string {
+	return `(` + alias + `.visibility = 'PUBLIC' OR ` + alias + `.user_id = ` + viewer + `
+		OR (` + alias + `.visibility = 'FOLLOWERS_ONLY' AND ` + followsAuthor(alias, viewer) + `)
+		OR (` + alias + `.visibility = 'MENTIONED_ONLY' AND ` + mentioned(alias, viewer) + `))`
+}
+
+// TweetRepliableBy return a sql condition true when the user id bound to
+// viewer can reply to the tweet aliased by alias.
+func TweetRepliableBy(alias, viewer string) string {
+	return `(` + alias + `.reply_policy = 'REPLY_EVERYONE' OR ` + alias + `.user_id = ` + viewer + `
+		OR (` + alias + `.reply_policy = 'REPLY_FOLLOWERS' AND ` + followsAuthor(alias, viewer) + `)
+		OR (` + alias + `.reply_policy = 'REPLY_MENTIONED' AND ` + mentioned(alias, viewer) + `))`
+}
+
+// followsAuthor sql condition true when viewer follows the tweet author.
+func followsAuthor(alias, viewer string) string {
+	return `EXISTS(SELECT 1 FROM follows vf WHERE vf.follower = ` + viewer + ` AND vf.followee = ` + alias + `.user_id)`
+}
+
+// mentioned sql condition true when viewer is mentioned in the tweet.
+func mentioned(alias, viewer string) string {
+	return `EXISTS(SELECT 1 FROM tweet_mentions vm WHERE vm.tweet_id = ` + alias + `.id AND vm.user_id = ` + viewer + `)`
+}
@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/idirall22/twee/pb"
+)
+
+var (
+	// urlRegexp match http and https urls.
+	urlRegexp = regexp.MustCompile(`https?://[^\s]+`)
+
+	// hashtagRegexp match a hashtag that contains at least one letter,
+	// the first group is the hashtag and the second its text.
+	hashtagRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&/#])(#([\p{L}\p{N}_]*\p{L}[\p{L}\p{N}_]*))`)
+
+	// mentionRegexp match a username, the first group is the mention and
+	// the second the username.
+	mentionRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@])(@([\p{L}\p{N}_]+))`)
+)
+
+// urlTrailingPunctuation characters removed from the end of an url.
+const urlTrailingPunctuation = `.,:;!?'")]}`
+
+// Parse extract hashtags, mentions and urls from a tweet content ordered
+// by offset, mentions are not resolved so their user id is zero.
+func Parse(content string) []*pb.TweetEntity {
+	entities := []*pb.TweetEntity{}
+
+	urls := urlRegexp.FindAllStringIndex(content, -1)
+	for _, loc := range urls {
+		url := strings.TrimRight(content[loc[0]:loc[1]], urlTrailingPunctuation)
+		loc[1] = loc[0] + len(url)
+		entities = append(entities, newEntity(pb.EntityType_ENTITY_URL, url, loc[0], loc[1]))
+	}
+
+	// hashtags and mentions inside urls are part of the url.
+	inURL := func(start int) bool {
+		for _, loc := range urls {
+			if start >= loc[0] && start < loc[1] {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, loc := range hashtagRegexp.FindAllStringSubmatchIndex(content, -1) {
+		if inURL(loc[2]) {
+			continue
+		}
+		entities = append(entities,
+			newEntity(pb.EntityType_ENTITY_HASHTAG, content[loc[4]:loc[5]], loc[2], loc[3]))
+	}
+
+	for _, loc := range mentionRegexp.FindAllStringSubmatchIndex(content, -1) {
+		if inURL(loc[2]) {
+			continue
+		}
+		entities = append(entities,
+			newEntity(pb.EntityType_ENTITY_MENTION, content[loc[4]:loc[5]], loc[2], loc[3]))
+	}
+
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Start < entities[j].Start
+	})
+
+	// rune offsets are computed in a single pass over the sorted entities.
+	runes, last := 0, 0
+	for _, e := range entities {
+		runes += utf8.RuneCountInString(content[last:e.Start])
+		e.RuneStart = int32(runes)
+		runes += utf8.RuneCountInString(content[e.Start:e.End])
+		e.RuneEnd = int32(runes)
+		last = int(e.End)
+	}
+
+	return entities
+}
+
+// Mentions return the usernames mentioned by entities without duplicates.
+func Mentions(entities []*pb.TweetEntity) []string {
+	seen := map[string]bool{}
+	usernames := []string{}
+	for _, e := range entities {
+		if e.Type != pb.EntityType_ENTITY_MENTION {
+			continue
+		}
+		key := strings.ToLower(e.Text)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		usernames = append(usernames, e.Text)
+	}
+	return usernames
+}
+
+// ResolveMentions set mentions user id using the ids map keyed by lower
+// case username, mentions of unknown users are removed.
+func ResolveMentions(entities []*pb.TweetEntity, ids map[string]int64) []*pb.TweetEntity {
+	resolved := make([]*pb.TweetEntity, 0, len(entities))
+	for _, e := range entities {
+		if e.Type == pb.EntityType_ENTITY_MENTION {
+			id, ok := ids[strings.ToLower(e.Text)]
+			if !ok {
+				continue
+			}
+			e.UserId = id
+		}
+		resolved = append(resolved, e)
+	}
+	return resolved
+}
+
+// MentionedUserIDs return the users mentioned by entities without duplicates.
+func MentionedUserIDs(entities []*pb.TweetEntity) []int64 {
+	seen := map[int64]bool{}
+	ids := []int64{}
+	for _, e := range entities {
+		if e.Type != pb.EntityType_ENTITY_MENTION || e.UserId == 0 || seen[e.UserId] {
+			continue
+		}
+		seen[e.UserId] = true
+		ids = append(ids, e.UserId)
+	}
+	return ids
+}
+
+func newEntity(t pb.EntityType, text string, start, end int) *pb.TweetEntity {
+	return &pb.TweetEntity{
+		Type:  t,
+		Text:  text,
+		Start: int32(start),
+		End:   int32(end),
+	}
+}
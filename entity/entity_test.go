@@ -0,0 +1,42 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/entity"
+	"github.com/idirall22/twee/pb"
+)
+
+func TestParse(t *testing.T) {
+	content := "héllo @Alice, #Go_lang et #2020 https://twee.io/#tag?a=b. mail a@b.c @bob"
+
+	entities := entity.Parse(content)
+	require.Len(t, entities, 4)
+
+	expected := []struct {
+		entityType pb.EntityType
+		text       string
+		raw        string
+	}{
+		{pb.EntityType_ENTITY_MENTION, "Alice", "@Alice"},
+		{pb.EntityType_ENTITY_HASHTAG, "Go_lang", "#Go_lang"},
+		{pb.EntityType_ENTITY_URL, "https://twee.io/#tag?a=b", "https://twee.io/#tag?a=b"},
+		{pb.EntityType_ENTITY_MENTION, "bob", "@bob"},
+	}
+
+	runes := []rune(content)
+	for i, e := range entities {
+		require.Equal(t, expected[i].entityType, e.Type)
+		require.Equal(t, expected[i].text, e.Text)
+		require.Equal(t, expected[i].raw, content[e.Start:e.End])
+		require.Equal(t, expected[i].raw, string(runes[e.RuneStart:e.RuneEnd]))
+	}
+
+	require.Equal(t, []string{"Alice", "bob"}, entity.Mentions(entities))
+
+	resolved := entity.ResolveMentions(entities, map[string]int64{"alice": 7})
+	require.Len(t, resolved, 3)
+	require.Equal(t, []int64{7}, entity.MentionedUserIDs(resolved))
+}
@@ -0,0 +1,214 @@
+package follow
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
+)
+
+var (
+	// importRowInterval minimum duration between two imported rows.
+	importRowInterval = time.Millisecond * 50
+
+	// maxImportRows maximum number of rows accepted by a single import.
+	maxImportRows int64 = 5000
+)
+
+// ImportFollows follow each username received from the stream and
+// report the result of every row.
+func (s *Server) ImportFollows(stream pb.FollowService_ImportFollowsServer) error {
+	ctx := stream.Context()
+
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	limiter := time.NewTicker(importRowInterval)
+	defer limiter.Stop()
+
+	var row int64
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Unknown, "Could not receive import row: %v", err)
+		}
+
+		row++
+		if row > maxImportRows {
+			return status.Errorf(codes.ResourceExhausted, "Import is limited to %d rows", maxImportRows)
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.Errorf(codes.Canceled, "Import canceled: %v", ctx.Err())
+		case <-limiter.C:
+		}
+
+		res, err := s.importRow(ctx, userInfos.ID, req.GetUsername())
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not import row %d: %v", row, err)
+		}
+		res.Row = row
+
+		err = stream.Send(res)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not send import result: %v", err)
+		}
+	}
+}
+
+// importRow follow a single username, errors that only concern the row
+// are reported in the response.
+func (s *Server) importRow(ctx context.Context, follower int64, username string) (*pb.ResponseImportFollows, error) {
+	username = strings.TrimPrefix(strings.TrimSpace(username), "@")
+	res := &pb.ResponseImportFollows{Username: username}
+
+	if len(username) == 0 {
+		res.Status = pb.ImportFollowStatus_INVALID_ROW
+		res.Error = "Empty username"
+		return res, nil
+	}
+
+	followee, err := s.followStore.FindUserID(ctx, username)
+	if err == utils.ErrUserRecordNotExists {
+		res.Status = pb.ImportFollowStatus_USER_NOT_FOUND
+		res.Error = err.Error()
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.Followee = followee
+
+	if followee == follower {
+		res.Status = pb.ImportFollowStatus_INVALID_ROW
+		res.Error = "Could not follow yourself"
+		return res, nil
+	}
+
+	created, err := s.followStore.Follow(ctx, follower, followee)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Status = pb.ImportFollowStatus_FOLLOWED
+	if !created {
+		res.Status = pb.ImportFollowStatus_ALREADY_FOLLOWED
+		return res, nil
+	}
+
+	s.publish(ctx, pb.Action_CREATED, follower, followee)
+	return res, nil
+}
+
+// ExportFollows stream the caller followers or followees as CSV or
+// newline-delimited JSON.
+func (s *Server) ExportFollows(req *pb.RequestExportFollows, stream pb.FollowService_ExportFollowsServer) error {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	header, encode, err := newFollowEncoder(req.GetFormat())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if header != nil {
+		err = stream.Send(&pb.ResponseExportFollows{Data: header})
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not send export header: %v", err)
+		}
+	}
+
+	err = s.followStore.ListFollowRecords(
+		stream.Context(),
+		userInfos.ID,
+		req.GetFollowType(),
+		func(r *pb.FollowRecord) error {
+			data, err := encode(r)
+			if err != nil {
+				return err
+			}
+			return stream.Send(&pb.ResponseExportFollows{Data: data})
+		},
+	)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not export follows: %v", err)
+	}
+
+	return nil
+}
+
+// followEncoder encode a follow record to a single export line.
+type followEncoder func(r *pb.FollowRecord) ([]byte, error)
+
+// newFollowEncoder return the export header and the record encoder of a format.
+func newFollowEncoder(format pb.ExportFormat) ([]byte, followEncoder, error) {
+	switch format {
+	case pb.ExportFormat_CSV:
+		header, err := encodeCSV("user_id", "username")
+		if err != nil {
+			return nil, nil, err
+		}
+		return header, func(r *pb.FollowRecord) ([]byte, error) {
+			return encodeCSV(strconv.FormatInt(r.UserId, 10), r.Username)
+		}, nil
+
+	case pb.ExportFormat_JSON:
+		return nil, func(r *pb.FollowRecord) ([]byte, error) {
+			data, err := common.ProtobufToJSON(r)
+			if err != nil {
+				return nil, fmt.Errorf("Could not serialize data to json: %v", err)
+			}
+
+			// one record per line.
+			buf := &bytes.Buffer{}
+			err = json.Compact(buf, []byte(data))
+			if err != nil {
+				return nil, fmt.Errorf("Could not compact json: %v", err)
+			}
+			buf.WriteByte('\n')
+			return buf.Bytes(), nil
+		}, nil
+	}
+
+	return nil, nil, fmt.Errorf("Unknown export format: %v", format)
+}
+
+// encodeCSV encode a single CSV row.
+func encodeCSV(fields ...string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+
+	err := w.Write(fields)
+	if err != nil {
+		return nil, fmt.Errorf("Could not write csv row: %v", err)
+	}
+
+	w.Flush()
+	if err = w.Error(); err != nil {
+		return nil, fmt.Errorf("Could not flush csv row: %v", err)
+	}
+	return buf.Bytes(), nil
+}
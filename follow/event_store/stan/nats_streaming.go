@@ -11,9 +11,9 @@ import (
 
 // NatsStreamingEventStore event store.
 type NatsStreamingEventStore struct {
-	cc           stan.Conn
-	subject      string
-	followEvents chan *pb.FollowEvent
+	cc      stan.Conn
+	subject string
+	done    chan struct{}
 }
 
 // NewNatsStreamingEventStore create new stan event store.
@@ -24,36 +24,39 @@ func NewNatsStreamingEventStore(subject, clusterID, clientID string, option ...s
 	}
 
 	return &NatsStreamingEventStore{
-		cc:           sc,
-		subject:      subject,
-		followEvents: make(chan *pb.FollowEvent, 128),
+		cc:      sc,
+		subject: subject,
+		done:    make(chan struct{}),
 	}, nil
 }
 
-// Start event store.
+// Start event store, events are published synchronously so it only waits
+// for the store to be closed.
 func (e *NatsStreamingEventStore) Start() error {
-	for {
-		select {
-		case n := <-e.followEvents:
-			data, err := common.ProtobufToJSON(n)
-			if err != nil {
-				return fmt.Errorf("Could not serialize data to json: %v", err)
-			}
-			err = e.cc.Publish(e.subject, []byte(data))
-			if err != nil {
-				return fmt.Errorf("Could not publish to nats: %v", err)
-			}
-		}
-	}
+	<-e.done
+	return nil
 }
 
-// Publish to event store
-func (e *NatsStreamingEventStore) Publish(ctx context.Context, te *pb.FollowEvent) error {
-	e.followEvents <- te
+// Publish to event store, it return once the event is acknowledged by nats.
+func (e *NatsStreamingEventStore) Publish(ctx context.Context, fe *pb.FollowEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := common.ProtobufToJSON(fe)
+	if err != nil {
+		return fmt.Errorf("Could not serialize data to json: %v", err)
+	}
+
+	err = e.cc.Publish(e.subject, []byte(data))
+	if err != nil {
+		return fmt.Errorf("Could not publish to nats: %v", err)
+	}
 	return nil
 }
 
 // Close event store connection.
 func (e *NatsStreamingEventStore) Close() error {
+	close(e.done)
 	return e.cc.Close()
 }
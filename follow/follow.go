@@ -3,6 +3,7 @@ package follow
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,6 +14,12 @@ import (
 	"github.com/idirall22/twee/pb"
 )
 
+// ServiceName audience of the service tokens calling the follow service.
+const ServiceName = "follow"
+
+// ServiceMethods methods other services are allowed to call.
+var ServiceMethods = []string{"/v1.FollowService/ListFollow"}
+
 // Server follow service struct.
 type Server struct {
 	followStore        store.FollowStore
@@ -26,11 +33,10 @@ func NewFollowServer(s store.FollowStore, es eventstore.EventStore) (*Server, er
 		return nil, fmt.Errorf("Store should not be NIL")
 	}
 
-	// if es == nil {
-	// 	return nil, fmt.Errorf("Event Store should not be NIL")
-	// }
-
-	// go es.Start()
+	// follow events are only published when an event store is set.
+	if es != nil {
+		go es.Start()
+	}
 
 	return &Server{
 		followStore: s,
@@ -48,22 +54,37 @@ func (s *Server) ToggleFollow(ctx context.Context, req *pb.RequestFollow) (*pb.R
 
 	followee := req.Followee
 	follower := userInfos.ID
-	_, err = s.followStore.ToggleFollow(ctx, follower, followee)
+	action, changed, err := s.followStore.ToggleFollow(ctx, follower, followee)
 
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not toggle follow: %v", err)
 	}
 
-	// publish follow event.
-	// go s.eventStore.Publish(ctx, &pb.FollowEvent{
-	// 	Action:   action,
-	// 	Followee: followee,
-	// 	Follower: follower,
-	// })
+	// a concurrent toggle already published the change.
+	if changed {
+		s.publish(ctx, action, follower, followee)
+	}
 
 	return &pb.ResponseFollow{}, nil
 }
 
+// publish a follow event, the follow is already saved so a failure is only
+// logged.
+func (s *Server) publish(ctx context.Context, action pb.Action, follower, followee int64) {
+	if s.eventStore == nil {
+		return
+	}
+
+	err := s.eventStore.Publish(ctx, &pb.FollowEvent{
+		Action:   action,
+		Followee: followee,
+		Follower: follower,
+	})
+	if err != nil {
+		log.Printf("Could not publish follow event: %v", err)
+	}
+}
+
 // ListFollow list followee or followers
 func (s *Server) ListFollow(ctx context.Context, req *pb.RequestListFollow) (*pb.ResponseListFollow, error) {
 	followsList, err := s.followStore.ListFollow(
@@ -2,10 +2,15 @@ package follow_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -85,6 +90,94 @@ func TestFollow(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resListFollow)
 
+	// import follows by username
+	importStream, err := followClient.ImportFollows(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, importStream)
+
+	imports := []struct {
+		username string
+		expected *pb.ResponseImportFollows
+	}{
+		{
+			username: reqRegister2.Username,
+			expected: &pb.ResponseImportFollows{
+				Row:      1,
+				Username: reqRegister2.Username,
+				Followee: userClaims.ID,
+				Status:   pb.ImportFollowStatus_FOLLOWED,
+			},
+		},
+		{
+			username: "@" + reqRegister.Username,
+			expected: &pb.ResponseImportFollows{
+				Row:      2,
+				Username: reqRegister.Username,
+				Followee: userClaims1.ID,
+				Status:   pb.ImportFollowStatus_INVALID_ROW,
+				Error:    "Could not follow yourself",
+			},
+		},
+		{
+			username: "",
+			expected: &pb.ResponseImportFollows{
+				Row:    3,
+				Status: pb.ImportFollowStatus_INVALID_ROW,
+				Error:  "Empty username",
+			},
+		},
+	}
+	for _, i := range imports {
+		err = importStream.Send(&pb.RequestImportFollows{Username: i.username})
+		require.NoError(t, err)
+
+		resImport, err := importStream.Recv()
+		require.NoError(t, err)
+		require.True(t, proto.Equal(i.expected, resImport), "row %d: %v", i.expected.Row, resImport)
+	}
+	require.NoError(t, importStream.CloseSend())
+
+	// export follows as csv and json, user 1 follows itself then user 2.
+	records := []*pb.FollowRecord{
+		{UserId: userClaims1.ID, Username: reqRegister.Username},
+		{UserId: userClaims.ID, Username: reqRegister2.Username},
+	}
+	export := func(format pb.ExportFormat) []string {
+		exportStream, err := followClient.ExportFollows(ctx, &pb.RequestExportFollows{
+			FollowType: pb.FollowListType_FOLLOWER,
+			Format:     format,
+		})
+		require.NoError(t, err)
+
+		lines := []string{}
+		for {
+			resExport, err := exportStream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			lines = append(lines, string(resExport.Data))
+		}
+		return lines
+	}
+
+	expectedCSV := []string{"user_id,username\n"}
+	for _, r := range records {
+		expectedCSV = append(expectedCSV, fmt.Sprintf("%d,%s\n", r.UserId, r.Username))
+	}
+	require.Equal(t, expectedCSV, export(pb.ExportFormat_CSV))
+
+	lines := export(pb.ExportFormat_JSON)
+	require.Len(t, lines, len(records))
+	for i, line := range lines {
+		require.True(t, strings.HasSuffix(line, "\n"))
+		require.True(t, json.Valid([]byte(line)))
+
+		data, err := common.ProtobufToJSON(records[i])
+		require.NoError(t, err)
+		require.JSONEq(t, data, line)
+	}
+
 	// resFollow, err = followClient.ToggleFollow(ctx, &pb.RequestFollow{Followee: userClaims.ID})
 	// require.NoError(t, err)
 	// require.NotNil(t, resFollow)
@@ -109,7 +202,10 @@ func startFollowTestServer(t *testing.T, jwtManager *auth.JwtManager) string {
 	require.NotNil(t, server)
 
 	jwtInterceptor := auth.NewJwtInterceptor(jwtManager)
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(jwtInterceptor.Unary()))
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(jwtInterceptor.Unary()),
+		grpc.StreamInterceptor(jwtInterceptor.Stream()),
+	)
 	pb.RegisterFollowServiceServer(grpcServer, server)
 
 	listner, err := net.Listen("tcp", ":0")
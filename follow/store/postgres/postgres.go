@@ -8,6 +8,7 @@ import (
 	"github.com/idirall22/twee/common"
 	option "github.com/idirall22/twee/options"
 	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
 )
 
 // PostgresFollowStore follow postgres store struct.
@@ -29,11 +30,12 @@ func NewPostgresFollowStore(opts *option.PostgresOptions) (*PostgresFollowStore,
 	}, nil
 }
 
-// ToggleFollow toggle folow a user.
-func (s *PostgresFollowStore) ToggleFollow(ctx context.Context, follower, followee int64) (pb.Action, error) {
+// ToggleFollow toggle folow a user, changed is false when a concurrent
+// toggle already made the change.
+func (s *PostgresFollowStore) ToggleFollow(ctx context.Context, follower, followee int64) (pb.Action, bool, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return pb.Action_UNKNOWNE_ACTION, fmt.Errorf("Could not start transaction: %v", err)
+		return pb.Action_UNKNOWNE_ACTION, false, fmt.Errorf("Could not start transaction: %v", err)
 	}
 	defer tx.Rollback()
 
@@ -44,38 +46,54 @@ func (s *PostgresFollowStore) ToggleFollow(ctx context.Context, follower, follow
 	)
 
 	if err != nil {
-		return pb.Action_UNKNOWNE_ACTION, fmt.Errorf("Could not prepare statment: %v", err)
+		return pb.Action_UNKNOWNE_ACTION, false, fmt.Errorf("Could not prepare statment: %v", err)
 	}
 
 	err = stmt.QueryRowContext(ctx, followee, follower).Scan(&exists)
 	if err != nil {
-		return pb.Action_UNKNOWNE_ACTION, fmt.Errorf("Could not check if record already exists: %v", err)
+		return pb.Action_UNKNOWNE_ACTION, false, fmt.Errorf("Could not check if record already exists: %v", err)
 	}
 
 	query := "DELETE FROM follows WHERE followee=$1 AND follower=$2"
-	action := pb.Action_UNKNOWNE_ACTION
+	action := pb.Action_DELETED
+	delta := -1
 
 	if !exists {
-		query = "INSERT INTO follows (followee, follower) VALUES ($1, $2)"
+		query = "INSERT INTO follows (followee, follower) VALUES ($1, $2) ON CONFLICT (follower, followee) DO NOTHING"
 		action = pb.Action_CREATED
+		delta = 1
 	}
 
 	stmt, err = tx.PrepareContext(ctx, query)
 	if err != nil {
-		return pb.Action_UNKNOWNE_ACTION, fmt.Errorf("Could not prepare %s follow statment: %v", action.String(), err)
+		return pb.Action_UNKNOWNE_ACTION, false, fmt.Errorf("Could not prepare %s follow statment: %v", action.String(), err)
 	}
 
-	_, err = stmt.ExecContext(ctx, followee, follower)
+	result, err := stmt.ExecContext(ctx, followee, follower)
 	if err != nil {
-		return pb.Action_UNKNOWNE_ACTION, fmt.Errorf("Could not %s record: %v", action.String(), err)
+		return pb.Action_UNKNOWNE_ACTION, false, fmt.Errorf("Could not %s record: %v", action.String(), err)
+	}
+
+	// a concurrent toggle may have already created or deleted the follow,
+	// the counts are only updated by the request that changed it.
+	count, err := result.RowsAffected()
+	if err != nil {
+		return pb.Action_UNKNOWNE_ACTION, false, fmt.Errorf("Could not get affected rows: %v", err)
+	}
+
+	if count > 0 {
+		err = updateFollowCounts(ctx, tx, follower, followee, delta)
+		if err != nil {
+			return pb.Action_UNKNOWNE_ACTION, false, err
+		}
 	}
 
 	err = tx.Commit()
 	if err != nil {
-		return pb.Action_UNKNOWNE_ACTION, fmt.Errorf("Could not commit transaction: %v", err)
+		return pb.Action_UNKNOWNE_ACTION, false, fmt.Errorf("Could not commit transaction: %v", err)
 	}
 
-	return action, nil
+	return action, count > 0, nil
 }
 
 // ListFollow followers or followee;
@@ -129,3 +147,141 @@ func (s *PostgresFollowStore) ListFollow(ctx context.Context, follower, followee
 
 	return followList, nil
 }
+
+// Follow a user, returns false if the follower already follow the followee.
+func (s *PostgresFollowStore) Follow(ctx context.Context, follower, followee int64) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("Could not start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(
+		ctx, `
+		INSERT INTO follows (followee, follower) VALUES ($1, $2)
+		ON CONFLICT (follower, followee) DO NOTHING`,
+	)
+	if err != nil {
+		return false, fmt.Errorf("Could not prepare statment: %v", err)
+	}
+
+	result, err := stmt.ExecContext(ctx, followee, follower)
+	if err != nil {
+		return false, fmt.Errorf("Could not create record: %v", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("Could not get affected rows: %v", err)
+	}
+
+	if count > 0 {
+		err = updateFollowCounts(ctx, tx, follower, followee, 1)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("Could not commit transaction: %v", err)
+	}
+
+	return count > 0, nil
+}
+
+// updateFollowCounts add delta to the follower followee count and to the
+// followee follower count.
+func updateFollowCounts(ctx context.Context, tx *sql.Tx, follower, followee int64, delta int) error {
+	_, err := tx.ExecContext(ctx, "UPDATE users SET followee_count=followee_count+$1 WHERE id=$2", delta, follower)
+	if err != nil {
+		return fmt.Errorf("Could not update followee count: %v", err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE users SET follower_count=follower_count+$1 WHERE id=$2", delta, followee)
+	if err != nil {
+		return fmt.Errorf("Could not update follower count: %v", err)
+	}
+	return nil
+}
+
+// FindUserID get a user id by username.
+func (s *PostgresFollowStore) FindUserID(ctx context.Context, username string) (int64, error) {
+	var id int64
+	err := s.db.QueryRowContext(
+		ctx, "SELECT id FROM users WHERE username=$1", username,
+	).Scan(&id)
+
+	if err == sql.ErrNoRows {
+		return 0, utils.ErrUserRecordNotExists
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("Could not find user: %v", err)
+	}
+
+	return id, nil
+}
+
+// ListFollowRecords list users followed by or following a user.
+func (s *PostgresFollowStore) ListFollowRecords(
+	ctx context.Context,
+	userID int64,
+	listType pb.FollowListType,
+	found func(r *pb.FollowRecord) error,
+) error {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// List users followed by the user.
+	query := `
+		SELECT u.id, u.username FROM follows f
+		INNER JOIN users u ON u.id = f.followee
+		WHERE f.follower=$1 ORDER BY f.id`
+	if listType == pb.FollowListType_FOLLOWEE {
+		// List the user followers.
+		query = `
+		SELECT u.id, u.username FROM follows f
+		INNER JOIN users u ON u.id = f.follower
+		WHERE f.followee=$1 ORDER BY f.id`
+	}
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("Could not prepare follow statment: %v", err)
+	}
+
+	rows, err := stmt.QueryContext(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("Could not query records: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		r := &pb.FollowRecord{}
+		err = rows.Scan(&r.UserId, &r.Username)
+		if err != nil {
+			return fmt.Errorf("Could not scan follow record: %v", err)
+		}
+
+		err = found(r)
+		if err != nil {
+			return fmt.Errorf("Could not send follow record: %v", err)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("Could not iterate follow records: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Could not commit transaction: %v", err)
+	}
+
+	return nil
+}
@@ -8,9 +8,20 @@ import (
 
 // FollowStore interface
 type FollowStore interface {
-	// ToggleFollow follow, unfollow a user
-	ToggleFollow(ctx context.Context, follower, followee int64) (pb.Action, error)
+	// ToggleFollow follow, unfollow a user, returns false if the follow was
+	// already created or deleted.
+	ToggleFollow(ctx context.Context, follower, followee int64) (pb.Action, bool, error)
 
 	// List followers or followee;
 	ListFollow(ctx context.Context, follower, followee int64, listType pb.FollowListType) ([]*pb.Follow, error)
+
+	// Follow a user, returns false if the follower already follow the followee.
+	Follow(ctx context.Context, follower, followee int64) (bool, error)
+
+	// FindUserID get a user id by username.
+	FindUserID(ctx context.Context, username string) (int64, error)
+
+	// ListFollowRecords list users followed by or following a user.
+	ListFollowRecords(ctx context.Context, userID int64, listType pb.FollowListType,
+		found func(r *pb.FollowRecord) error) error
 }
@@ -14,6 +14,22 @@ func NewRequestCreateTweet() *pb.CreateTweetRequest {
 	}
 }
 
+// NewRequestReplyTweet create new request reply to a tweet
+func NewRequestReplyTweet(replyTo int64) *pb.CreateTweetRequest {
+	return &pb.CreateTweetRequest{
+		Content: randomWord(),
+		ReplyTo: replyTo,
+	}
+}
+
+// NewRequestQuoteTweet create new request quote a tweet
+func NewRequestQuoteTweet(quoteTweetID int64) *pb.CreateTweetRequest {
+	return &pb.CreateTweetRequest{
+		Content:      randomWord(),
+		QuoteTweetId: quoteTweetID,
+	}
+}
+
 // NewRequestUpdateTweet create new request update tweet
 func NewRequestUpdateTweet(id int64) *pb.UpdateTweetRequest {
 	return &pb.UpdateTweetRequest{
@@ -31,7 +47,7 @@ func NewRequestGetTweet(id int64) *pb.GetTweetRequest {
 
 // NewRequestListTweet create new request list tweet
 func NewRequestListTweet(id int64) *pb.ListTweetRequest {
-	return &pb.ListTweetRequest{}
+	return &pb.ListTweetRequest{UserId: id}
 }
 
 // NewRequestDeleteTweet create new request delete tweet
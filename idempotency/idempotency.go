@@ -0,0 +1,217 @@
+// Package idempotency replays the response of write RPCs retried with the
+// same idempotency-key metadata.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+)
+
+const (
+	// KeyHeader metadata holding the idempotency key.
+	KeyHeader = "idempotency-key"
+
+	// ReplayedHeader header set to true on replayed responses.
+	ReplayedHeader = "idempotency-replayed"
+
+	// maxKeyLength maximum length of an idempotency key.
+	maxKeyLength = 255
+
+	// storeTimeout maximum duration of a write of a record, records are
+	// written even when the request context is canceled.
+	storeTimeout = time.Second * 5
+)
+
+// DefaultMethods write RPCs that create resources or notify users.
+var DefaultMethods = []string{
+	"/v1.tweetService/Create",
+	"/v1.tweetService/Retweet",
+	"/v1.tweetService/Like",
+	"/v1.tweetService/Vote",
+	"/v1.tweetService/Bookmark",
+	"/v1.AuthService/Register",
+	"/v1.FollowService/ToggleFollow",
+}
+
+// Key identify a request, keys are scoped by user and method.
+type Key struct {
+	// UserID caller id, zero for anonymous callers.
+	UserID int64
+	Key    string
+	Method string
+}
+
+// Record a reserved key.
+type Record struct {
+	// RequestHash hash of the request that reserved the key.
+	RequestHash string
+	// Response nil while the request is in progress.
+	Response *any.Any
+}
+
+// Store idempotency records store.
+type Store interface {
+	// Reserve a key for lease, the current record is returned when the key
+	// is already reserved and nil when it is reserved for the caller.
+	Reserve(ctx context.Context, k *Key, requestHash string, lease time.Duration) (*Record, error)
+	// Complete save the response of a reserved key and keep it for ttl.
+	Complete(ctx context.Context, k *Key, response *any.Any, ttl time.Duration) error
+	// Release a reserved key so the request can be retried.
+	Release(ctx context.Context, k *Key) error
+}
+
+// Interceptor replay responses of requests sent with an idempotency key.
+type Interceptor struct {
+	store   Store
+	ttl     time.Duration
+	lease   time.Duration
+	methods map[string]bool
+}
+
+// NewInterceptor create new idempotency interceptor for the methods, it
+// must run after the authentication interceptor. Responses are replayed
+// for ttl, a request in progress holds its key for lease so a crashed
+// request does not block its retries for ttl.
+func NewInterceptor(s Store, ttl, lease time.Duration, methods []string) *Interceptor {
+	i := &Interceptor{
+		store:   s,
+		ttl:     ttl,
+		lease:   lease,
+		methods: make(map[string]bool, len(methods)),
+	}
+	for _, m := range methods {
+		i.methods[m] = true
+	}
+	return i
+}
+
+// Unary run the handler once per idempotency key and replay its response.
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !i.methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		key, err := keyFromContext(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		if key == nil {
+			return handler(ctx, req)
+		}
+
+		hash, err := requestHash(req)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not hash request: %v", err)
+		}
+
+		record, err := i.store.Reserve(ctx, key, hash, i.lease)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not reserve idempotency key: %v", err)
+		}
+		if record != nil {
+			return replay(ctx, record, hash)
+		}
+
+		res, err := handler(ctx, req)
+
+		// the client may have given up, the record is written anyway so
+		// its retries are not blocked.
+		sctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+		defer cancel()
+
+		if err != nil {
+			// failed requests can be retried with the same key.
+			if rerr := i.store.Release(sctx, key); rerr != nil {
+				return nil, status.Errorf(codes.Internal, "Could not release idempotency key: %v", rerr)
+			}
+			return nil, err
+		}
+
+		msg, ok := res.(proto.Message)
+		if !ok {
+			return res, nil
+		}
+		a, err := ptypes.MarshalAny(msg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not encode response: %v", err)
+		}
+
+		err = i.store.Complete(sctx, key, a, i.ttl)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not save response: %v", err)
+		}
+		return res, nil
+	}
+}
+
+// keyFromContext return the request key, nil if no key is sent.
+func keyFromContext(ctx context.Context, method string) (*Key, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[KeyHeader]) == 0 {
+		return nil, nil
+	}
+
+	value := md[KeyHeader][0]
+	if len(value) == 0 || len(value) > maxKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Idempotency key must be 1 to %d characters long", maxKeyLength)
+	}
+
+	k := &Key{Key: value, Method: method}
+	// anonymous methods like Register are scoped by method only.
+	if userInfos, err := auth.GetUserInfosFromContext(ctx); err == nil {
+		k.UserID = userInfos.ID
+	}
+	return k, nil
+}
+
+// replay return the saved response of a record.
+func replay(ctx context.Context, record *Record, hash string) (interface{}, error) {
+	if record.RequestHash != hash {
+		return nil, status.Errorf(codes.InvalidArgument, "Idempotency key already used by another request")
+	}
+	if record.Response == nil {
+		return nil, status.Errorf(codes.Aborted, "A request with this idempotency key is in progress")
+	}
+
+	var res ptypes.DynamicAny
+	err := ptypes.UnmarshalAny(record.Response, &res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not decode response: %v", err)
+	}
+
+	grpc.SetHeader(ctx, metadata.Pairs(ReplayedHeader, "true"))
+	return res.Message, nil
+}
+
+// requestHash return the hex sha256 of a request.
+func requestHash(req interface{}) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("Request is not a protobuf message")
+	}
+
+	b := proto.NewBuffer(nil)
+	b.SetDeterministic(true)
+	err := b.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b.Bytes())
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,167 @@
+package idempotency_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/idempotency"
+	memoryidempotency "github.com/idirall22/twee/idempotency/memory"
+	"github.com/idirall22/twee/pb"
+)
+
+func TestInterceptor(t *testing.T) {
+	const method = "/v1.tweetService/Create"
+
+	ms := memoryidempotency.NewMemoryStore()
+	interceptor := idempotency.NewInterceptor(
+		&contextStore{ms},
+		time.Millisecond*100,
+		time.Millisecond*50,
+		[]string{method},
+	).Unary()
+
+	calls := 0
+	fail := false
+	var cancelRequest context.CancelFunc
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if cancelRequest != nil {
+			// the client gave up while the request was handled.
+			cancelRequest()
+		}
+		if fail {
+			return nil, fmt.Errorf("failure")
+		}
+		return &pb.CreateTweetResponse{Id: int64(calls)}, nil
+	}
+
+	newContext := func(userID int64, key string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotency.KeyHeader, key))
+		return context.WithValue(ctx, auth.ClaimKey("claims"), &auth.UserClaims{ID: userID})
+	}
+
+	call := func(ctx context.Context, req *pb.CreateTweetRequest, m string) (int64, error) {
+		res, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: m}, handler)
+		if err != nil {
+			return 0, err
+		}
+		return res.(*pb.CreateTweetResponse).Id, nil
+	}
+
+	req := &pb.CreateTweetRequest{Content: "hello"}
+
+	// retries are replayed.
+	id, err := call(newContext(1, "a"), req, method)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), id)
+
+	id, err = call(newContext(1, "a"), proto.Clone(req).(*pb.CreateTweetRequest), method)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), id)
+	require.Equal(t, 1, calls)
+
+	// keys are scoped by user.
+	id, err = call(newContext(2, "a"), req, method)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), id)
+
+	// a key can not be reused for another request.
+	_, err = call(newContext(1, "a"), &pb.CreateTweetRequest{Content: "other"}, method)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// other methods are not intercepted.
+	id, err = call(newContext(1, "a"), req, "/v1.tweetService/Update")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), id)
+
+	// failed requests can be retried.
+	fail = true
+	_, err = call(newContext(1, "b"), req, method)
+	require.Error(t, err)
+
+	fail = false
+	id, err = call(newContext(1, "b"), req, method)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), id)
+
+	// responses of canceled requests are replayed.
+	ctx, cancel := context.WithCancel(newContext(1, "c"))
+	cancelRequest = cancel
+	id, err = call(ctx, req, method)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), id)
+
+	cancelRequest = nil
+	id, err = call(newContext(1, "c"), req, method)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), id)
+
+	// a request in progress holds its key for the lease only.
+	record, err := ms.Reserve(context.Background(), &idempotency.Key{UserID: 1, Key: "d", Method: method},
+		mustHash(t, req), time.Millisecond*50)
+	require.NoError(t, err)
+	require.Nil(t, record)
+
+	_, err = call(newContext(1, "d"), req, method)
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	time.Sleep(time.Millisecond * 60)
+	id, err = call(newContext(1, "d"), req, method)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), id)
+
+	// responses are kept for the ttl, not the lease.
+	time.Sleep(time.Millisecond * 60)
+	id, err = call(newContext(1, "d"), req, method)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), id)
+
+	// responses expire.
+	time.Sleep(time.Millisecond * 60)
+	id, err = call(newContext(1, "d"), req, method)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), id)
+}
+
+// contextStore fail like a database when the context is done.
+type contextStore struct {
+	idempotency.Store
+}
+
+func (s *contextStore) Complete(ctx context.Context, k *idempotency.Key, response *any.Any,
+	ttl time.Duration) error {
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return s.Store.Complete(ctx, k, response, ttl)
+}
+
+func (s *contextStore) Release(ctx context.Context, k *idempotency.Key) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return s.Store.Release(ctx, k)
+}
+
+// mustHash return the hash the interceptor compute for a request.
+func mustHash(t *testing.T, req proto.Message) string {
+	b := proto.NewBuffer(nil)
+	b.SetDeterministic(true)
+	require.NoError(t, b.Marshal(req))
+	sum := sha256.Sum256(b.Bytes())
+	return hex.EncodeToString(sum[:])
+}
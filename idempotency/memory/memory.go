@@ -0,0 +1,87 @@
+package memoryidempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+
+	"github.com/idirall22/twee/idempotency"
+)
+
+// entry a reserved key.
+type entry struct {
+	record    *idempotency.Record
+	expiresAt time.Time
+}
+
+// MemoryStore keep idempotency records in memory, expired records are
+// removed when a key is reserved.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[idempotency.Key]*entry
+}
+
+// NewMemoryStore create new in memory idempotency store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: map[idempotency.Key]*entry{}}
+}
+
+// Reserve a key for lease.
+func (m *MemoryStore) Reserve(ctx context.Context, k *idempotency.Key, requestHash string,
+	lease time.Duration) (*idempotency.Record, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for key, e := range m.entries {
+		if !now.Before(e.expiresAt) {
+			delete(m.entries, key)
+		}
+	}
+
+	if e, ok := m.entries[*k]; ok {
+		return copyRecord(e.record), nil
+	}
+
+	m.entries[*k] = &entry{
+		record:    &idempotency.Record{RequestHash: requestHash},
+		expiresAt: now.Add(lease),
+	}
+	return nil, nil
+}
+
+// Complete save the response of a reserved key and keep it for ttl.
+func (m *MemoryStore) Complete(ctx context.Context, k *idempotency.Key, response *any.Any,
+	ttl time.Duration) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.entries[*k]; ok {
+		e.record.Response = proto.Clone(response).(*any.Any)
+		e.expiresAt = time.Now().Add(ttl)
+	}
+	return nil
+}
+
+// Release a reserved key.
+func (m *MemoryStore) Release(ctx context.Context, k *idempotency.Key) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, *k)
+	return nil
+}
+
+// copyRecord return a copy of a record.
+func copyRecord(r *idempotency.Record) *idempotency.Record {
+	c := &idempotency.Record{RequestHash: r.RequestHash}
+	if r.Response != nil {
+		c.Response = proto.Clone(r.Response).(*any.Any)
+	}
+	return c
+}
@@ -0,0 +1,121 @@
+package postgresidempotency
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/idempotency"
+	option "github.com/idirall22/twee/options"
+)
+
+// PostgresStore keep idempotency records in postgres so they are shared
+// by every instance of a service.
+type PostgresStore struct {
+	options *option.PostgresOptions
+	db      *sql.DB
+}
+
+// NewPostgresStore create new postgres idempotency store.
+func NewPostgresStore(opts *option.PostgresOptions) (*PostgresStore, error) {
+	_, db, err := common.SetupPostgres(opts)
+	if err != nil {
+		return nil, fmt.Errorf("Could not connect to db: %v", err)
+	}
+
+	return &PostgresStore{
+		options: opts,
+		db:      db,
+	}, nil
+}
+
+// Reserve a key for lease, an expired record is replaced.
+func (p *PostgresStore) Reserve(ctx context.Context, k *idempotency.Key, requestHash string,
+	lease time.Duration) (*idempotency.Record, error) {
+
+	var reserved bool
+	err := p.db.QueryRowContext(
+		ctx, `
+		INSERT INTO idempotency_keys (user_id, key, method, request_hash, expires_at)
+		VALUES ($1, $2, $3, $4, now() + make_interval(secs => $5))
+		ON CONFLICT (user_id, key, method) DO UPDATE
+		SET request_hash=EXCLUDED.request_hash, response_type=NULL, response=NULL,
+			expires_at=EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= now()
+		RETURNING true`,
+		k.UserID, k.Key, k.Method, requestHash, lease.Seconds(),
+	).Scan(&reserved)
+
+	if err == nil {
+		return nil, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, fmt.Errorf("Could not reserve key: %v", err)
+	}
+
+	record := &idempotency.Record{}
+	var responseType sql.NullString
+	var response []byte
+	err = p.db.QueryRowContext(
+		ctx,
+		"SELECT request_hash, response_type, response FROM idempotency_keys WHERE user_id=$1 AND key=$2 AND method=$3",
+		k.UserID, k.Key, k.Method,
+	).Scan(&record.RequestHash, &responseType, &response)
+
+	if err != nil {
+		return nil, fmt.Errorf("Could not get key: %v", err)
+	}
+
+	if responseType.Valid {
+		record.Response = &any.Any{TypeUrl: responseType.String, Value: response}
+	}
+	return record, nil
+}
+
+// Complete save the response of a reserved key and keep it for ttl.
+func (p *PostgresStore) Complete(ctx context.Context, k *idempotency.Key, response *any.Any,
+	ttl time.Duration) error {
+
+	_, err := p.db.ExecContext(
+		ctx, `
+		UPDATE idempotency_keys
+		SET response_type=$4, response=$5, expires_at=now() + make_interval(secs => $6)
+		WHERE user_id=$1 AND key=$2 AND method=$3`,
+		k.UserID, k.Key, k.Method, response.TypeUrl, response.Value, ttl.Seconds(),
+	)
+	if err != nil {
+		return fmt.Errorf("Could not save response: %v", err)
+	}
+	return nil
+}
+
+// Release a reserved key.
+func (p *PostgresStore) Release(ctx context.Context, k *idempotency.Key) error {
+	_, err := p.db.ExecContext(
+		ctx,
+		"DELETE FROM idempotency_keys WHERE user_id=$1 AND key=$2 AND method=$3",
+		k.UserID, k.Key, k.Method,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not release key: %v", err)
+	}
+	return nil
+}
+
+// DeleteExpired remove expired records.
+func (p *PostgresStore) DeleteExpired(ctx context.Context) error {
+	_, err := p.db.ExecContext(ctx, "DELETE FROM idempotency_keys WHERE expires_at <= now()")
+	if err != nil {
+		return fmt.Errorf("Could not delete expired keys: %v", err)
+	}
+	return nil
+}
+
+// Close close connections
+func (p *PostgresStore) Close() error {
+	return p.db.Close()
+}
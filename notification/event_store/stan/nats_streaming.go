@@ -25,7 +25,7 @@ type NatsStreamingEventStore struct {
 	subject            string
 	tweetNotifications chan string
 	notifications      chan *pb.Notification
-	done               chan error
+	done               chan struct{}
 }
 
 // NewNatsStreamingEventStore create new NatsStreamingEventStore.
@@ -46,56 +46,31 @@ func NewNatsStreamingEventStore(
 		subject:            subject,
 		cc:                 cc,
 		tweetNotifications: make(chan string, 128),
+		notifications:      make(chan *pb.Notification, 128),
+		done:               make(chan struct{}),
 	}, nil
 }
 
-// Start NatsStreamingEventStore.
+// Start NatsStreamingEventStore, it waits for the store to be closed.
 func (e *NatsStreamingEventStore) Start() error {
 	log.Println("Event store started")
 
 	go func() {
 		for {
-			msg := <-e.tweetNotifications
-			tn := &pb.TweetEvent{}
-			err := common.JSONToProtobufMessage(msg, tn)
-			if err != nil {
-				log.Println("----------------Error JSON")
-				e.done <- fmt.Errorf("Could not parse json: %v", err)
+			select {
+			case <-e.done:
 				return
-			}
-
-			var followersList []*pb.Follow
-			// ctx := metadata.AppendToOutgoingContext(context.Background(), auth.AuthKey, uc.Token)
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-			defer cancel()
-			res, err := e.followService.ListFollow(ctx, &pb.RequestListFollow{
-				FollowType: pb.FollowListType_FOLLOWEE,
-				Followee:   tn.UserId,
-			})
-
-			if err != nil {
-				log.Println("----------------Error ListFollows", err.Error())
-				e.done <- fmt.Errorf("Could not list followers: %v", err)
-				break
-			}
-
-			followersList = res.Follows
-
-			if len(followersList) == 0 {
-				log.Println("User have no followers yet")
-				continue
-			}
-			err = e.notificationStore.NewTweetNotification(ctx, followersList, tn, e.notifications)
-			if err != nil {
-				e.done <- fmt.Errorf("Could not create notifications: %v", err)
-				break
+			case msg := <-e.tweetNotifications:
+				// a bad event is skipped, it must not stop the others.
+				err := e.handle(msg)
+				if err != nil {
+					log.Printf("Could not handle tweet event: %v", err)
+				}
 			}
 		}
 	}()
 
 	sub, err := e.cc.Subscribe(e.subject, func(msg *stan.Msg) {
-		log.Println("New notification received")
-		log.Println(string(msg.Data))
 		e.tweetNotifications <- string(msg.Data)
 	})
 
@@ -104,15 +79,161 @@ func (e *NatsStreamingEventStore) Start() error {
 	}
 
 	defer sub.Close()
-	return <-e.done
+	<-e.done
+	return nil
+}
+
+// handle create the notifications of a tweet event.
+func (e *NatsStreamingEventStore) handle(msg string) error {
+	tn := &pb.TweetEvent{}
+	err := common.JSONToProtobufMessage(msg, tn)
+	if err != nil {
+		return fmt.Errorf("Could not parse json: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// closed polls are updates of the tweet, they notify the author and
+	// the voters.
+	if tn.Type == pb.Type_POLL {
+		return e.newPollNotifications(ctx, tn)
+	}
+
+	// other edits and deletions do not notify anyone.
+	if tn.Action != pb.Action_CREATED {
+		return nil
+	}
+
+	// retweets only feed home timelines.
+	if tn.Type == pb.Type_RETWEET {
+		return nil
+	}
+
+	// mentions notify each mentioned user.
+	if tn.Type == pb.Type_MENTION {
+		return e.newMentionNotifications(ctx, tn)
+	}
+
+	// replies and likes only notify the tweet author.
+	if n := authorNotification(tn); n != nil {
+		if n.UserId == n.UserOrigin {
+			return nil
+		}
+		err = e.newNotification(ctx, n)
+		if err != nil {
+			return fmt.Errorf("Could not create %s notification: %v", n.Type, err)
+		}
+		return nil
+	}
+
+	if tn.Visibility == pb.Visibility_MENTIONED_ONLY {
+		return nil
+	}
+
+	res, err := e.followService.ListFollow(ctx, &pb.RequestListFollow{
+		FollowType: pb.FollowListType_FOLLOWEE,
+		Followee:   tn.UserId,
+	})
+	if err != nil {
+		return fmt.Errorf("Could not list followers: %v", err)
+	}
+
+	if len(res.Follows) == 0 {
+		return nil
+	}
+
+	err = e.notificationStore.NewTweetNotification(ctx, res.Follows, tn, e.notifications)
+	if err != nil {
+		return fmt.Errorf("Could not create notifications: %v", err)
+	}
+	return nil
+}
+
+// newNotification create a notification and send it to the recipient
+// streams.
+func (e *NatsStreamingEventStore) newNotification(ctx context.Context, n *pb.Notification) error {
+	err := e.notificationStore.NewNotification(ctx, n)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case e.notifications <- n:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("Could not send notification: %v", ctx.Err())
+	}
+}
+
+// newMentionNotifications create a notification for each mentioned user.
+func (e *NatsStreamingEventStore) newMentionNotifications(ctx context.Context, tn *pb.TweetEvent) error {
+	for _, userID := range tn.MentionedUserIds {
+		if userID == tn.UserId {
+			continue
+		}
+
+		err := e.newNotification(ctx, &pb.Notification{
+			UserOrigin: tn.UserId,
+			UserId:     userID,
+			Type:       pb.Type_MENTION,
+			TypeId:     tn.TweetId,
+			Title:      tn.Title,
+		})
+		if err != nil {
+			return fmt.Errorf("Could not create mention notification: %v", err)
+		}
+	}
+	return nil
+}
+
+// newPollNotifications create a notification for each recipient of a
+// closed poll, the author included.
+func (e *NatsStreamingEventStore) newPollNotifications(ctx context.Context, tn *pb.TweetEvent) error {
+	for _, userID := range tn.RecipientUserIds {
+		err := e.newNotification(ctx, &pb.Notification{
+			UserOrigin: tn.UserId,
+			UserId:     userID,
+			Type:       pb.Type_POLL,
+			TypeId:     tn.TweetId,
+			Title:      tn.Title,
+		})
+		if err != nil {
+			return fmt.Errorf("Could not create poll notification: %v", err)
+		}
+	}
+	return nil
+}
+
+// authorNotification return the notification of events that concern only
+// the tweet author, nil if the event should be sent to followers.
+func authorNotification(tn *pb.TweetEvent) *pb.Notification {
+	n := &pb.Notification{
+		UserOrigin: tn.UserId,
+		TypeId:     tn.TweetId,
+		Title:      tn.Title,
+	}
+
+	switch {
+	case tn.Type == pb.Type_LIKE:
+		n.Type = pb.Type_LIKE
+		n.UserId = tn.TweetUserId
+	case tn.InReplyToUserId != 0:
+		n.Type = pb.Type_REPLY
+		n.UserId = tn.InReplyToUserId
+	default:
+		return nil
+	}
+	return n
 }
 
 // Close close
 func (e *NatsStreamingEventStore) Close() error {
+	close(e.done)
 	return e.cc.Close()
 }
 
-// Subscribe get notification channel
+// Subscribe get the created notifications channel
 func (e *NatsStreamingEventStore) Subscribe() <-chan *pb.Notification {
 	return e.notifications
 }
@@ -0,0 +1,67 @@
+package seventstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/notification/store"
+	"github.com/idirall22/twee/pb"
+)
+
+// memoryStore keep the created notifications.
+type memoryStore struct {
+	store.Store
+	notifications []*pb.Notification
+}
+
+func (s *memoryStore) NewNotification(ctx context.Context, n *pb.Notification) error {
+	n.Id = int64(len(s.notifications) + 1)
+	s.notifications = append(s.notifications, n)
+	return nil
+}
+
+func TestHandlePollClosed(t *testing.T) {
+	ns := &memoryStore{}
+	e := &NatsStreamingEventStore{
+		notificationStore: ns,
+		notifications:     make(chan *pb.Notification, 10),
+	}
+
+	msg, err := common.ProtobufToJSON(&pb.TweetEvent{
+		Action:           pb.Action_UPDATED,
+		Type:             pb.Type_POLL,
+		Title:            "alice's poll has ended",
+		TweetId:          7,
+		UserId:           1,
+		TweetUserId:      1,
+		RecipientUserIds: []int64{1, 2, 3},
+	})
+	require.NoError(t, err)
+	require.NoError(t, e.handle(msg))
+
+	// the author and each voter are notified.
+	require.Len(t, ns.notifications, 3)
+	require.Len(t, e.notifications, 3)
+	for i, n := range ns.notifications {
+		require.Equal(t, int64(i+1), n.UserId)
+		require.Equal(t, int64(1), n.UserOrigin)
+		require.Equal(t, pb.Type_POLL, n.Type)
+		require.Equal(t, int64(7), n.TypeId)
+		require.Equal(t, "alice's poll has ended", n.Title)
+		require.Equal(t, n, <-e.notifications)
+	}
+
+	// other updates notify no one.
+	msg, err = common.ProtobufToJSON(&pb.TweetEvent{
+		Action:  pb.Action_UPDATED,
+		Type:    pb.Type_TWEET,
+		TweetId: 8,
+		UserId:  1,
+	})
+	require.NoError(t, err)
+	require.NoError(t, e.handle(msg))
+	require.Len(t, ns.notifications, 3)
+}
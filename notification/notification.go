@@ -2,8 +2,8 @@ package notification
 
 import (
 	"errors"
-	"fmt"
 
+	"github.com/idirall22/twee/auth"
 	"github.com/idirall22/twee/follow"
 
 	"google.golang.org/grpc/codes"
@@ -19,7 +19,8 @@ type Server struct {
 	running     bool
 	store       store.Store
 	eventStore  notifeventstore.EventStore
-	connections map[int64]*pb.NotificationService_NotifyServer
+	connections *registry
+	done        chan struct{}
 }
 
 // NewNotificationServer create notification server service
@@ -40,11 +41,31 @@ func NewNotificationServer(
 		return nil, errors.New("Follow service should not be nil")
 	}
 
-	return &Server{
+	server := &Server{
 		store:       ns,
 		eventStore:  es,
-		connections: make(map[int64]*pb.NotificationService_NotifyServer, 128),
-	}, nil
+		connections: newRegistry(),
+		done:        make(chan struct{}),
+	}
+	go server.dispatch(es.Subscribe())
+
+	return server, nil
+}
+
+// dispatch route the notifications to their recipient streams until the
+// server is closed.
+func (s *Server) dispatch(notifications <-chan *pb.Notification) {
+	for {
+		select {
+		case <-s.done:
+			return
+		case n, ok := <-notifications:
+			if !ok {
+				return
+			}
+			s.connections.deliver(n)
+		}
+	}
 }
 
 // Start notification service
@@ -56,16 +77,23 @@ func (s *Server) Start() error {
 	return errors.New("Serivce already started")
 }
 
-// Notify client
+// Notify stream the caller notifications until the stream is closed.
 func (s *Server) Notify(req *pb.NotifyRequest, stream pb.NotificationService_NotifyServer) error {
-	notificationsChan := s.eventStore.Subscribe()
-	// 1- get user id
-	// 2- store user stream in connections
+	userInfos, err := auth.GetUserInfosFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	c := s.connections.register(userInfos.ID)
+	defer s.connections.deregister(c)
 
 	for {
 		select {
-		case n := <-notificationsChan:
-			fmt.Println(n)
+		case <-stream.Context().Done():
+			return nil
+		case <-s.done:
+			return status.Errorf(codes.Unavailable, "Notification service closed")
+		case n := <-c.notifications:
 			err := stream.Send(&pb.NotifyResponse{Notification: n})
 			if err != nil {
 				return status.Errorf(codes.Internal, "Error to send notification: %v", err)
@@ -76,5 +104,6 @@ func (s *Server) Notify(req *pb.NotifyRequest, stream pb.NotificationService_Not
 
 // Close service.
 func (s *Server) Close() error {
+	close(s.done)
 	return s.eventStore.Close()
 }
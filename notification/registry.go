@@ -0,0 +1,82 @@
+package notification
+
+import (
+	"log"
+	"sync"
+
+	"github.com/idirall22/twee/pb"
+)
+
+// connectionBuffer maximum number of notifications waiting to be sent on a
+// stream, notifications to a slower stream are dropped, they are still
+// listed from the store.
+const connectionBuffer = 64
+
+// registry the open notify streams by user, a user may be connected from
+// several devices.
+type registry struct {
+	mu    sync.RWMutex
+	conns map[int64]map[*connection]bool
+}
+
+// connection an open notify stream.
+type connection struct {
+	userID        int64
+	notifications chan *pb.Notification
+}
+
+func newRegistry() *registry {
+	return &registry{conns: map[int64]map[*connection]bool{}}
+}
+
+// register a stream of a user.
+func (r *registry) register(userID int64) *connection {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := &connection{
+		userID:        userID,
+		notifications: make(chan *pb.Notification, connectionBuffer),
+	}
+	if r.conns[userID] == nil {
+		r.conns[userID] = map[*connection]bool{}
+	}
+	r.conns[userID][c] = true
+	return c
+}
+
+// deregister a closed stream.
+func (r *registry) deregister(c *connection) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.conns[c.userID], c)
+	if len(r.conns[c.userID]) == 0 {
+		delete(r.conns, c.userID)
+	}
+}
+
+// deliver a notification to the streams of its recipient, return the
+// number of streams it was queued on.
+func (r *registry) deliver(n *pb.Notification) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	delivered := 0
+	for c := range r.conns[n.UserId] {
+		select {
+		case c.notifications <- n:
+			delivered++
+		default:
+			log.Printf("Notification %d dropped, stream of user %d is full", n.Id, n.UserId)
+		}
+	}
+	return delivered
+}
+
+// count the open streams of a user.
+func (r *registry) count(userID int64) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.conns[userID])
+}
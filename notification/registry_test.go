@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/pb"
+)
+
+func TestRegistry(t *testing.T) {
+	r := newRegistry()
+
+	phone := r.register(1)
+	laptop := r.register(1)
+	other := r.register(2)
+	require.Equal(t, 2, r.count(1))
+
+	// each device of the recipient receives the notification.
+	require.Equal(t, 2, r.deliver(&pb.Notification{Id: 1, UserId: 1}))
+	require.Equal(t, int64(1), (<-phone.notifications).Id)
+	require.Equal(t, int64(1), (<-laptop.notifications).Id)
+	require.Empty(t, other.notifications)
+
+	// users without open stream receive nothing.
+	require.Equal(t, 0, r.deliver(&pb.Notification{Id: 2, UserId: 3}))
+
+	r.deregister(phone)
+	require.Equal(t, 1, r.deliver(&pb.Notification{Id: 3, UserId: 1}))
+	require.Empty(t, phone.notifications)
+	require.Equal(t, int64(3), (<-laptop.notifications).Id)
+
+	r.deregister(laptop)
+	r.deregister(other)
+	require.Empty(t, r.conns)
+
+	// a full stream drops notifications without blocking the others.
+	slow := r.register(1)
+	fast := r.register(1)
+	for i := 0; i < connectionBuffer; i++ {
+		slow.notifications <- &pb.Notification{}
+	}
+	require.Equal(t, 1, r.deliver(&pb.Notification{Id: 4, UserId: 1}))
+	require.Equal(t, int64(4), (<-fast.notifications).Id)
+}
@@ -4,7 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strings"
+
+	"github.com/lib/pq"
 
 	"github.com/idirall22/twee/common"
 	option "github.com/idirall22/twee/options"
@@ -43,25 +44,71 @@ func (s *PostgresNotificationStore) NewTweetNotification(
 	}
 	defer tx.Rollback()
 
-	args := []string{}
+	followers := make([]int64, 0, len(followersList))
 	for _, follow := range followersList {
-		args = append(args,
-			fmt.Sprintf("(%d, '%s', %d, '%s', %d, false)",
-				te.UserId, pb.Type_TWEET, te.TweetId, te.Title, follow.Follower),
-		)
+		followers = append(followers, follow.Follower)
 	}
-	query := fmt.Sprintf(
-		"INSERT INTO notifications (user_origin, type, type_id, title, user_id, opened) values %s",
-		strings.Join(args, ","),
+
+	rows, err := tx.QueryContext(
+		ctx, `
+		INSERT INTO notifications (user_origin, type, type_id, title, user_id, opened)
+		SELECT $1, $2, $3, $4, f.id, false FROM unnest($5::int[]) AS f (id)
+		RETURNING id, user_id`,
+		te.UserId, pb.Type_TWEET.String(), te.TweetId, te.Title, pq.Array(followers),
 	)
-	_, err = tx.ExecContext(ctx, query)
 	if err != nil {
-		return err
+		return fmt.Errorf("Could not create notifications: %v", err)
+	}
+	defer rows.Close()
+
+	notifications := []*pb.Notification{}
+	for rows.Next() {
+		n := &pb.Notification{
+			UserOrigin: te.UserId,
+			Type:       pb.Type_TWEET,
+			TypeId:     te.TweetId,
+			Title:      te.Title,
+		}
+		err = rows.Scan(&n.Id, &n.UserId)
+		if err != nil {
+			return fmt.Errorf("Could not scan notification: %v", err)
+		}
+		notifications = append(notifications, n)
 	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("Could not iterate notifications: %v", err)
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return fmt.Errorf("Could not commit transaction: %v", err)
 	}
+
+	// created notifications are sent to the recipients streams.
+	if notifChan == nil {
+		return nil
+	}
+	for _, n := range notifications {
+		select {
+		case notifChan <- n:
+		case <-ctx.Done():
+			return fmt.Errorf("Could not send notifications: %v", ctx.Err())
+		}
+	}
+	return nil
+}
+
+// NewNotification create a notification for a single user, n id is set.
+func (s *PostgresNotificationStore) NewNotification(ctx context.Context, n *pb.Notification) error {
+	err := s.db.QueryRowContext(
+		ctx, `
+		INSERT INTO notifications (user_origin, type, type_id, title, user_id, opened)
+		VALUES ($1, $2, $3, $4, $5, false) RETURNING id`,
+		n.UserOrigin, n.Type.String(), n.TypeId, n.Title, n.UserId,
+	).Scan(&n.Id)
+	if err != nil {
+		return fmt.Errorf("Could not create notification: %v", err)
+	}
 	return nil
 }
 
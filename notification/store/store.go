@@ -8,9 +8,12 @@ import (
 
 // Store store notification interface.
 type Store interface {
-	// New create notification
+	// New create notification, the created notifications are sent on
+	// cNotification when not nil
 	NewTweetNotification(ctx context.Context, followersList []*pb.Follow, notif *pb.TweetEvent,
 		cNotification chan<- *pb.Notification) error
+	// NewNotification create a notification for a single user and set its id
+	NewNotification(ctx context.Context, n *pb.Notification) error
 	// List notifications
 	List(ctx context.Context, userID int64, found func(n *pb.Notification) error) error
 }
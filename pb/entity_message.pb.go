@@ -0,0 +1,272 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.11.4
+// source: entity_message.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type EntityType int32
+
+const (
+	EntityType_UNKNOWNE_ENTITY EntityType = 0
+	EntityType_ENTITY_HASHTAG  EntityType = 1
+	EntityType_ENTITY_MENTION  EntityType = 2
+	EntityType_ENTITY_URL      EntityType = 3
+)
+
+// Enum value maps for EntityType.
+var (
+	EntityType_name = map[int32]string{
+		0: "UNKNOWNE_ENTITY",
+		1: "ENTITY_HASHTAG",
+		2: "ENTITY_MENTION",
+		3: "ENTITY_URL",
+	}
+	EntityType_value = map[string]int32{
+		"UNKNOWNE_ENTITY": 0,
+		"ENTITY_HASHTAG":  1,
+		"ENTITY_MENTION":  2,
+		"ENTITY_URL":      3,
+	}
+)
+
+func (x EntityType) Enum() *EntityType {
+	p := new(EntityType)
+	*p = x
+	return p
+}
+
+func (x EntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_entity_message_proto_enumTypes[0].Descriptor()
+}
+
+func (EntityType) Type() protoreflect.EnumType {
+	return &file_entity_message_proto_enumTypes[0]
+}
+
+func (x EntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityType.Descriptor instead.
+func (EntityType) EnumDescriptor() ([]byte, []int) {
+	return file_entity_message_proto_rawDescGZIP(), []int{0}
+}
+
+// TweetEntity a hashtag, mention or url found in a tweet content,
+// start and end cover the whole entity including # and @.
+type TweetEntity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type EntityType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.EntityType" json:"type,omitempty"`
+	// hashtag without #, username without @ or the url.
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// byte offsets in the content, end excluded.
+	Start int32 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End   int32 `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	// rune offsets in the content, end excluded.
+	RuneStart int32 `protobuf:"varint,5,opt,name=rune_start,json=runeStart,proto3" json:"rune_start,omitempty"`
+	RuneEnd   int32 `protobuf:"varint,6,opt,name=rune_end,json=runeEnd,proto3" json:"rune_end,omitempty"`
+	// mentioned user id.
+	UserId int64 `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *TweetEntity) Reset() {
+	*x = TweetEntity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entity_message_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TweetEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TweetEntity) ProtoMessage() {}
+
+func (x *TweetEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_entity_message_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TweetEntity.ProtoReflect.Descriptor instead.
+func (*TweetEntity) Descriptor() ([]byte, []int) {
+	return file_entity_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TweetEntity) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_UNKNOWNE_ENTITY
+}
+
+func (x *TweetEntity) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TweetEntity) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TweetEntity) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *TweetEntity) GetRuneStart() int32 {
+	if x != nil {
+		return x.RuneStart
+	}
+	return 0
+}
+
+func (x *TweetEntity) GetRuneEnd() int32 {
+	if x != nil {
+		return x.RuneEnd
+	}
+	return 0
+}
+
+func (x *TweetEntity) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+var File_entity_message_proto protoreflect.FileDescriptor
+
+var file_entity_message_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x22, 0xc0, 0x01, 0x0a, 0x0b, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75,
+	0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x72, 0x75, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6e,
+	0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x75, 0x6e,
+	0x65, 0x45, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x2a, 0x59, 0x0a,
+	0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x54,
+	0x41, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4d,
+	0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4e, 0x54, 0x49,
+	0x54, 0x59, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x03, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_entity_message_proto_rawDescOnce sync.Once
+	file_entity_message_proto_rawDescData = file_entity_message_proto_rawDesc
+)
+
+func file_entity_message_proto_rawDescGZIP() []byte {
+	file_entity_message_proto_rawDescOnce.Do(func() {
+		file_entity_message_proto_rawDescData = protoimpl.X.CompressGZIP(file_entity_message_proto_rawDescData)
+	})
+	return file_entity_message_proto_rawDescData
+}
+
+var file_entity_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_entity_message_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_entity_message_proto_goTypes = []interface{}{
+	(EntityType)(0),     // 0: v1.EntityType
+	(*TweetEntity)(nil), // 1: v1.TweetEntity
+}
+var file_entity_message_proto_depIdxs = []int32{
+	0, // 0: v1.TweetEntity.type:type_name -> v1.EntityType
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_entity_message_proto_init() }
+func file_entity_message_proto_init() {
+	if File_entity_message_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_entity_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TweetEntity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_entity_message_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_entity_message_proto_goTypes,
+		DependencyIndexes: file_entity_message_proto_depIdxs,
+		EnumInfos:         file_entity_message_proto_enumTypes,
+		MessageInfos:      file_entity_message_proto_msgTypes,
+	}.Build()
+	File_entity_message_proto = out.File
+	file_entity_message_proto_rawDesc = nil
+	file_entity_message_proto_goTypes = nil
+	file_entity_message_proto_depIdxs = nil
+}
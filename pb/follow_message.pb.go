@@ -71,6 +71,108 @@ func (FollowListType) EnumDescriptor() ([]byte, []int) {
 	return file_follow_message_proto_rawDescGZIP(), []int{0}
 }
 
+// export/import format.
+type ExportFormat int32
+
+const (
+	ExportFormat_CSV  ExportFormat = 0
+	ExportFormat_JSON ExportFormat = 1
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "CSV",
+		1: "JSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"CSV":  0,
+		"JSON": 1,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_follow_message_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_follow_message_proto_enumTypes[1]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_follow_message_proto_rawDescGZIP(), []int{1}
+}
+
+type ImportFollowStatus int32
+
+const (
+	ImportFollowStatus_UNKNOWNE_IMPORT_STATUS ImportFollowStatus = 0
+	ImportFollowStatus_FOLLOWED               ImportFollowStatus = 1
+	ImportFollowStatus_ALREADY_FOLLOWED       ImportFollowStatus = 2
+	ImportFollowStatus_USER_NOT_FOUND         ImportFollowStatus = 3
+	ImportFollowStatus_INVALID_ROW            ImportFollowStatus = 4
+)
+
+// Enum value maps for ImportFollowStatus.
+var (
+	ImportFollowStatus_name = map[int32]string{
+		0: "UNKNOWNE_IMPORT_STATUS",
+		1: "FOLLOWED",
+		2: "ALREADY_FOLLOWED",
+		3: "USER_NOT_FOUND",
+		4: "INVALID_ROW",
+	}
+	ImportFollowStatus_value = map[string]int32{
+		"UNKNOWNE_IMPORT_STATUS": 0,
+		"FOLLOWED":               1,
+		"ALREADY_FOLLOWED":       2,
+		"USER_NOT_FOUND":         3,
+		"INVALID_ROW":            4,
+	}
+)
+
+func (x ImportFollowStatus) Enum() *ImportFollowStatus {
+	p := new(ImportFollowStatus)
+	*p = x
+	return p
+}
+
+func (x ImportFollowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFollowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_follow_message_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportFollowStatus) Type() protoreflect.EnumType {
+	return &file_follow_message_proto_enumTypes[2]
+}
+
+func (x ImportFollowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFollowStatus.Descriptor instead.
+func (ImportFollowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_follow_message_proto_rawDescGZIP(), []int{2}
+}
+
 type Follow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -197,6 +299,62 @@ func (x *FollowEvent) GetFollower() int64 {
 	return 0
 }
 
+// a followed or following user, used by export.
+type FollowRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *FollowRecord) Reset() {
+	*x = FollowRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_follow_message_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRecord) ProtoMessage() {}
+
+func (x *FollowRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_message_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRecord.ProtoReflect.Descriptor instead.
+func (*FollowRecord) Descriptor() ([]byte, []int) {
+	return file_follow_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FollowRecord) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FollowRecord) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
 var File_follow_message_proto protoreflect.FileDescriptor
 
 var file_follow_message_proto_rawDesc = []byte{
@@ -214,11 +372,25 @@ var file_follow_message_proto_rawDesc = []byte{
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
 	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x2a, 0x2c, 0x0a,
-	0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0c, 0x0a, 0x08, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x45, 0x10, 0x01, 0x42, 0x06, 0x5a, 0x04, 0x2e,
-	0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x43, 0x0a,
+	0x0c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x2a, 0x2c, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x45, 0x10, 0x01,
+	0x2a, 0x21, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f,
+	0x4e, 0x10, 0x01, 0x2a, 0x79, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x46,
+	0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x4f, 0x57, 0x10, 0x04, 0x42, 0x06,
+	0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -233,16 +405,19 @@ func file_follow_message_proto_rawDescGZIP() []byte {
 	return file_follow_message_proto_rawDescData
 }
 
-var file_follow_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_follow_message_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_follow_message_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_follow_message_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_follow_message_proto_goTypes = []interface{}{
-	(FollowListType)(0), // 0: v1.FollowListType
-	(*Follow)(nil),      // 1: v1.Follow
-	(*FollowEvent)(nil), // 2: v1.FollowEvent
-	(Action)(0),         // 3: v1.Action
+	(FollowListType)(0),     // 0: v1.FollowListType
+	(ExportFormat)(0),       // 1: v1.ExportFormat
+	(ImportFollowStatus)(0), // 2: v1.ImportFollowStatus
+	(*Follow)(nil),          // 3: v1.Follow
+	(*FollowEvent)(nil),     // 4: v1.FollowEvent
+	(*FollowRecord)(nil),    // 5: v1.FollowRecord
+	(Action)(0),             // 6: v1.Action
 }
 var file_follow_message_proto_depIdxs = []int32{
-	3, // 0: v1.FollowEvent.action:type_name -> v1.Action
+	6, // 0: v1.FollowEvent.action:type_name -> v1.Action
 	1, // [1:1] is the sub-list for method output_type
 	1, // [1:1] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
@@ -281,14 +456,26 @@ func file_follow_message_proto_init() {
 				return nil
 			}
 		}
+		file_follow_message_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_follow_message_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
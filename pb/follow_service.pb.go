@@ -224,6 +224,236 @@ func (*ResponseFollow) Descriptor() ([]byte, []int) {
 	return file_follow_service_proto_rawDescGZIP(), []int{3}
 }
 
+type RequestImportFollows struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *RequestImportFollows) Reset() {
+	*x = RequestImportFollows{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_follow_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestImportFollows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestImportFollows) ProtoMessage() {}
+
+func (x *RequestImportFollows) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestImportFollows.ProtoReflect.Descriptor instead.
+func (*RequestImportFollows) Descriptor() ([]byte, []int) {
+	return file_follow_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RequestImportFollows) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ResponseImportFollows struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row      int64              `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Username string             `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Followee int64              `protobuf:"varint,3,opt,name=followee,proto3" json:"followee,omitempty"`
+	Status   ImportFollowStatus `protobuf:"varint,4,opt,name=status,proto3,enum=v1.ImportFollowStatus" json:"status,omitempty"`
+	Error    string             `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ResponseImportFollows) Reset() {
+	*x = ResponseImportFollows{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_follow_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseImportFollows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseImportFollows) ProtoMessage() {}
+
+func (x *ResponseImportFollows) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseImportFollows.ProtoReflect.Descriptor instead.
+func (*ResponseImportFollows) Descriptor() ([]byte, []int) {
+	return file_follow_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResponseImportFollows) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ResponseImportFollows) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ResponseImportFollows) GetFollowee() int64 {
+	if x != nil {
+		return x.Followee
+	}
+	return 0
+}
+
+func (x *ResponseImportFollows) GetStatus() ImportFollowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportFollowStatus_UNKNOWNE_IMPORT_STATUS
+}
+
+func (x *ResponseImportFollows) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RequestExportFollows struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// FOLLOWER export users followed by the caller,
+	// FOLLOWEE export the caller followers.
+	FollowType FollowListType `protobuf:"varint,1,opt,name=follow_type,json=followType,proto3,enum=v1.FollowListType" json:"follow_type,omitempty"`
+	Format     ExportFormat   `protobuf:"varint,2,opt,name=format,proto3,enum=v1.ExportFormat" json:"format,omitempty"`
+}
+
+func (x *RequestExportFollows) Reset() {
+	*x = RequestExportFollows{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_follow_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestExportFollows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestExportFollows) ProtoMessage() {}
+
+func (x *RequestExportFollows) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestExportFollows.ProtoReflect.Descriptor instead.
+func (*RequestExportFollows) Descriptor() ([]byte, []int) {
+	return file_follow_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RequestExportFollows) GetFollowType() FollowListType {
+	if x != nil {
+		return x.FollowType
+	}
+	return FollowListType_FOLLOWER
+}
+
+func (x *RequestExportFollows) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_CSV
+}
+
+type ResponseExportFollows struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ResponseExportFollows) Reset() {
+	*x = ResponseExportFollows{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_follow_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseExportFollows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseExportFollows) ProtoMessage() {}
+
+func (x *ResponseExportFollows) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseExportFollows.ProtoReflect.Descriptor instead.
+func (*ResponseExportFollows) Descriptor() ([]byte, []int) {
+	return file_follow_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResponseExportFollows) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_follow_service_proto protoreflect.FileDescriptor
 
 var file_follow_service_proto_rawDesc = []byte{
@@ -245,17 +475,50 @@ var file_follow_service_proto_rawDesc = []byte{
 	0x2b, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
 	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65, 0x22, 0x10, 0x0a, 0x0e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x32, 0x83,
-	0x01, 0x0a, 0x0d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x35, 0x0a, 0x0c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x12, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x1a, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x3b, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x1a, 0x16, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x32,
+	0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x75, 0x0a, 0x14,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x22, 0x2b, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x32, 0x95, 0x02, 0x0a, 0x0d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x12, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x1a, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x3b, 0x0a, 0x0a, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x1a, 0x16,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x48, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x73, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x46, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x73, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x1a, 0x19, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x30, 0x01, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -270,27 +533,40 @@ func file_follow_service_proto_rawDescGZIP() []byte {
 	return file_follow_service_proto_rawDescData
 }
 
-var file_follow_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_follow_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_follow_service_proto_goTypes = []interface{}{
-	(*RequestListFollow)(nil),  // 0: v1.RequestListFollow
-	(*ResponseListFollow)(nil), // 1: v1.ResponseListFollow
-	(*RequestFollow)(nil),      // 2: v1.RequestFollow
-	(*ResponseFollow)(nil),     // 3: v1.ResponseFollow
-	(FollowListType)(0),        // 4: v1.FollowListType
-	(*Follow)(nil),             // 5: v1.Follow
+	(*RequestListFollow)(nil),     // 0: v1.RequestListFollow
+	(*ResponseListFollow)(nil),    // 1: v1.ResponseListFollow
+	(*RequestFollow)(nil),         // 2: v1.RequestFollow
+	(*ResponseFollow)(nil),        // 3: v1.ResponseFollow
+	(*RequestImportFollows)(nil),  // 4: v1.RequestImportFollows
+	(*ResponseImportFollows)(nil), // 5: v1.ResponseImportFollows
+	(*RequestExportFollows)(nil),  // 6: v1.RequestExportFollows
+	(*ResponseExportFollows)(nil), // 7: v1.ResponseExportFollows
+	(FollowListType)(0),           // 8: v1.FollowListType
+	(*Follow)(nil),                // 9: v1.Follow
+	(ImportFollowStatus)(0),       // 10: v1.ImportFollowStatus
+	(ExportFormat)(0),             // 11: v1.ExportFormat
 }
 var file_follow_service_proto_depIdxs = []int32{
-	4, // 0: v1.RequestListFollow.follow_type:type_name -> v1.FollowListType
-	5, // 1: v1.ResponseListFollow.Follows:type_name -> v1.Follow
-	2, // 2: v1.FollowService.ToggleFollow:input_type -> v1.RequestFollow
-	0, // 3: v1.FollowService.ListFollow:input_type -> v1.RequestListFollow
-	3, // 4: v1.FollowService.ToggleFollow:output_type -> v1.ResponseFollow
-	1, // 5: v1.FollowService.ListFollow:output_type -> v1.ResponseListFollow
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8,  // 0: v1.RequestListFollow.follow_type:type_name -> v1.FollowListType
+	9,  // 1: v1.ResponseListFollow.Follows:type_name -> v1.Follow
+	10, // 2: v1.ResponseImportFollows.status:type_name -> v1.ImportFollowStatus
+	8,  // 3: v1.RequestExportFollows.follow_type:type_name -> v1.FollowListType
+	11, // 4: v1.RequestExportFollows.format:type_name -> v1.ExportFormat
+	2,  // 5: v1.FollowService.ToggleFollow:input_type -> v1.RequestFollow
+	0,  // 6: v1.FollowService.ListFollow:input_type -> v1.RequestListFollow
+	4,  // 7: v1.FollowService.ImportFollows:input_type -> v1.RequestImportFollows
+	6,  // 8: v1.FollowService.ExportFollows:input_type -> v1.RequestExportFollows
+	3,  // 9: v1.FollowService.ToggleFollow:output_type -> v1.ResponseFollow
+	1,  // 10: v1.FollowService.ListFollow:output_type -> v1.ResponseListFollow
+	5,  // 11: v1.FollowService.ImportFollows:output_type -> v1.ResponseImportFollows
+	7,  // 12: v1.FollowService.ExportFollows:output_type -> v1.ResponseExportFollows
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_follow_service_proto_init() }
@@ -348,6 +624,54 @@ func file_follow_service_proto_init() {
 				return nil
 			}
 		}
+		file_follow_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestImportFollows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_follow_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseImportFollows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_follow_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestExportFollows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_follow_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseExportFollows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -355,7 +679,7 @@ func file_follow_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_follow_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -385,6 +709,10 @@ type FollowServiceClient interface {
 	ToggleFollow(ctx context.Context, in *RequestFollow, opts ...grpc.CallOption) (*ResponseFollow, error)
 	// List
 	ListFollow(ctx context.Context, in *RequestListFollow, opts ...grpc.CallOption) (*ResponseListFollow, error)
+	// ImportFollows follow a stream of usernames
+	ImportFollows(ctx context.Context, opts ...grpc.CallOption) (FollowService_ImportFollowsClient, error)
+	// ExportFollows export followers or followees as CSV or JSON
+	ExportFollows(ctx context.Context, in *RequestExportFollows, opts ...grpc.CallOption) (FollowService_ExportFollowsClient, error)
 }
 
 type followServiceClient struct {
@@ -413,12 +741,79 @@ func (c *followServiceClient) ListFollow(ctx context.Context, in *RequestListFol
 	return out, nil
 }
 
+func (c *followServiceClient) ImportFollows(ctx context.Context, opts ...grpc.CallOption) (FollowService_ImportFollowsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FollowService_serviceDesc.Streams[0], "/v1.FollowService/ImportFollows", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &followServiceImportFollowsClient{stream}
+	return x, nil
+}
+
+type FollowService_ImportFollowsClient interface {
+	Send(*RequestImportFollows) error
+	Recv() (*ResponseImportFollows, error)
+	grpc.ClientStream
+}
+
+type followServiceImportFollowsClient struct {
+	grpc.ClientStream
+}
+
+func (x *followServiceImportFollowsClient) Send(m *RequestImportFollows) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *followServiceImportFollowsClient) Recv() (*ResponseImportFollows, error) {
+	m := new(ResponseImportFollows)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *followServiceClient) ExportFollows(ctx context.Context, in *RequestExportFollows, opts ...grpc.CallOption) (FollowService_ExportFollowsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FollowService_serviceDesc.Streams[1], "/v1.FollowService/ExportFollows", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &followServiceExportFollowsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FollowService_ExportFollowsClient interface {
+	Recv() (*ResponseExportFollows, error)
+	grpc.ClientStream
+}
+
+type followServiceExportFollowsClient struct {
+	grpc.ClientStream
+}
+
+func (x *followServiceExportFollowsClient) Recv() (*ResponseExportFollows, error) {
+	m := new(ResponseExportFollows)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // FollowServiceServer is the server API for FollowService service.
 type FollowServiceServer interface {
 	// ToggleFollow
 	ToggleFollow(context.Context, *RequestFollow) (*ResponseFollow, error)
 	// List
 	ListFollow(context.Context, *RequestListFollow) (*ResponseListFollow, error)
+	// ImportFollows follow a stream of usernames
+	ImportFollows(FollowService_ImportFollowsServer) error
+	// ExportFollows export followers or followees as CSV or JSON
+	ExportFollows(*RequestExportFollows, FollowService_ExportFollowsServer) error
 }
 
 // UnimplementedFollowServiceServer can be embedded to have forward compatible implementations.
@@ -431,6 +826,12 @@ func (*UnimplementedFollowServiceServer) ToggleFollow(context.Context, *RequestF
 func (*UnimplementedFollowServiceServer) ListFollow(context.Context, *RequestListFollow) (*ResponseListFollow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFollow not implemented")
 }
+func (*UnimplementedFollowServiceServer) ImportFollows(FollowService_ImportFollowsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportFollows not implemented")
+}
+func (*UnimplementedFollowServiceServer) ExportFollows(*RequestExportFollows, FollowService_ExportFollowsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportFollows not implemented")
+}
 
 func RegisterFollowServiceServer(s *grpc.Server, srv FollowServiceServer) {
 	s.RegisterService(&_FollowService_serviceDesc, srv)
@@ -472,6 +873,53 @@ func _FollowService_ListFollow_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FollowService_ImportFollows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FollowServiceServer).ImportFollows(&followServiceImportFollowsServer{stream})
+}
+
+type FollowService_ImportFollowsServer interface {
+	Send(*ResponseImportFollows) error
+	Recv() (*RequestImportFollows, error)
+	grpc.ServerStream
+}
+
+type followServiceImportFollowsServer struct {
+	grpc.ServerStream
+}
+
+func (x *followServiceImportFollowsServer) Send(m *ResponseImportFollows) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *followServiceImportFollowsServer) Recv() (*RequestImportFollows, error) {
+	m := new(RequestImportFollows)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FollowService_ExportFollows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RequestExportFollows)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FollowServiceServer).ExportFollows(m, &followServiceExportFollowsServer{stream})
+}
+
+type FollowService_ExportFollowsServer interface {
+	Send(*ResponseExportFollows) error
+	grpc.ServerStream
+}
+
+type followServiceExportFollowsServer struct {
+	grpc.ServerStream
+}
+
+func (x *followServiceExportFollowsServer) Send(m *ResponseExportFollows) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _FollowService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.FollowService",
 	HandlerType: (*FollowServiceServer)(nil),
@@ -485,6 +933,18 @@ var _FollowService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _FollowService_ListFollow_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportFollows",
+			Handler:       _FollowService_ImportFollows_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportFollows",
+			Handler:       _FollowService_ExportFollows_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "follow_service.proto",
 }
@@ -30,6 +30,8 @@ type TimelineType int32
 const (
 	TimelineType_OWNER TimelineType = 0
 	TimelineType_HOME  TimelineType = 1
+	// tweets of followed and second degree accounts ordered by relevance.
+	TimelineType_RANKED TimelineType = 2
 )
 
 // Enum value maps for TimelineType.
@@ -37,10 +39,12 @@ var (
 	TimelineType_name = map[int32]string{
 		0: "OWNER",
 		1: "HOME",
+		2: "RANKED",
 	}
 	TimelineType_value = map[string]int32{
-		"OWNER": 0,
-		"HOME":  1,
+		"OWNER":  0,
+		"HOME":   1,
+		"RANKED": 2,
 	}
 )
 
@@ -71,6 +75,66 @@ func (TimelineType) EnumDescriptor() ([]byte, []int) {
 	return file_timeline_message_proto_rawDescGZIP(), []int{0}
 }
 
+// why a tweet is shown in a ranked timeline.
+type RankReason int32
+
+const (
+	RankReason_REASON_UNSPECIFIED RankReason = 0
+	// the viewer follows the author.
+	RankReason_REASON_FOLLOWED RankReason = 1
+	// an account followed by the viewer follows the author.
+	RankReason_REASON_SECOND_DEGREE RankReason = 2
+	// the tweet has many likes and retweets.
+	RankReason_REASON_ENGAGEMENT RankReason = 3
+	// the viewer often interacts with the author.
+	RankReason_REASON_AFFINITY RankReason = 4
+)
+
+// Enum value maps for RankReason.
+var (
+	RankReason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "REASON_FOLLOWED",
+		2: "REASON_SECOND_DEGREE",
+		3: "REASON_ENGAGEMENT",
+		4: "REASON_AFFINITY",
+	}
+	RankReason_value = map[string]int32{
+		"REASON_UNSPECIFIED":   0,
+		"REASON_FOLLOWED":      1,
+		"REASON_SECOND_DEGREE": 2,
+		"REASON_ENGAGEMENT":    3,
+		"REASON_AFFINITY":      4,
+	}
+)
+
+func (x RankReason) Enum() *RankReason {
+	p := new(RankReason)
+	*p = x
+	return p
+}
+
+func (x RankReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RankReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_timeline_message_proto_enumTypes[1].Descriptor()
+}
+
+func (RankReason) Type() protoreflect.EnumType {
+	return &file_timeline_message_proto_enumTypes[1]
+}
+
+func (x RankReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RankReason.Descriptor instead.
+func (RankReason) EnumDescriptor() ([]byte, []int) {
+	return file_timeline_message_proto_rawDescGZIP(), []int{1}
+}
+
 type Timeline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -114,10 +178,19 @@ var File_timeline_message_proto protoreflect.FileDescriptor
 var file_timeline_message_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x22, 0x0a, 0x0a, 0x08,
-	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2a, 0x23, 0x0a, 0x0c, 0x54, 0x69, 0x6d, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2a, 0x2f, 0x0a, 0x0c, 0x54, 0x69, 0x6d, 0x65,
 	0x6c, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45,
-	0x52, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x4d, 0x45, 0x10, 0x01, 0x42, 0x06, 0x5a,
-	0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x52, 0x41, 0x4e, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x7f, 0x0a, 0x0a, 0x52, 0x61, 0x6e,
+	0x6b, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
+	0x45, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x47, 0x52, 0x45, 0x45, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x47, 0x41, 0x47, 0x45, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x41, 0x46, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x59, 0x10, 0x04, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -132,11 +205,12 @@ func file_timeline_message_proto_rawDescGZIP() []byte {
 	return file_timeline_message_proto_rawDescData
 }
 
-var file_timeline_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_timeline_message_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_timeline_message_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_timeline_message_proto_goTypes = []interface{}{
 	(TimelineType)(0), // 0: v1.TimelineType
-	(*Timeline)(nil),  // 1: v1.Timeline
+	(RankReason)(0),   // 1: v1.RankReason
+	(*Timeline)(nil),  // 2: v1.Timeline
 }
 var file_timeline_message_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -170,7 +244,7 @@ func file_timeline_message_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_timeline_message_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
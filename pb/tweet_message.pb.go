@@ -31,10 +31,35 @@ type Tweet struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content   string               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	UserId    string               `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id               int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content          string               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	UserId           string               `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt        *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	InReplyToTweetId int64                `protobuf:"varint,5,opt,name=in_reply_to_tweet_id,json=inReplyToTweetId,proto3" json:"in_reply_to_tweet_id,omitempty"`
+	ConversationId   int64                `protobuf:"varint,6,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	RetweetOfId      int64                `protobuf:"varint,7,opt,name=retweet_of_id,json=retweetOfId,proto3" json:"retweet_of_id,omitempty"`
+	QuotedTweetId    int64                `protobuf:"varint,8,opt,name=quoted_tweet_id,json=quotedTweetId,proto3" json:"quoted_tweet_id,omitempty"`
+	RetweetCount     uint32               `protobuf:"varint,9,opt,name=retweet_count,json=retweetCount,proto3" json:"retweet_count,omitempty"`
+	// deleted tweets are kept as tombstones without content.
+	Deleted bool `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// retweeted or quoted tweet.
+	Original  *Tweet         `protobuf:"bytes,11,opt,name=original,proto3" json:"original,omitempty"`
+	LikeCount uint32         `protobuf:"varint,12,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	Liked     bool           `protobuf:"varint,13,opt,name=liked,proto3" json:"liked,omitempty"`
+	Entities  []*TweetEntity `protobuf:"bytes,14,rep,name=entities,proto3" json:"entities,omitempty"`
+	// last edit date, not set if the tweet was never edited.
+	EditedAt      *timestamp.Timestamp `protobuf:"bytes,15,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	RevisionCount uint32               `protobuf:"varint,16,opt,name=revision_count,json=revisionCount,proto3" json:"revision_count,omitempty"`
+	Media         []*Media             `protobuf:"bytes,17,rep,name=media,proto3" json:"media,omitempty"`
+	Poll          *Poll                `protobuf:"bytes,18,opt,name=poll,proto3" json:"poll,omitempty"`
+	Visibility    Visibility           `protobuf:"varint,19,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
+	ReplyPolicy   ReplyPolicy          `protobuf:"varint,20,opt,name=reply_policy,json=replyPolicy,proto3,enum=v1.ReplyPolicy" json:"reply_policy,omitempty"`
+	// whether the viewer is allowed to reply.
+	CanReply bool `protobuf:"varint,21,opt,name=can_reply,json=canReply,proto3" json:"can_reply,omitempty"`
+	// whether the viewer bookmarked the tweet.
+	Bookmarked bool `protobuf:"varint,22,opt,name=bookmarked,proto3" json:"bookmarked,omitempty"`
+	// pinned on its author profile.
+	Pinned bool `protobuf:"varint,23,opt,name=pinned,proto3" json:"pinned,omitempty"`
 }
 
 func (x *Tweet) Reset() {
@@ -97,21 +122,355 @@ func (x *Tweet) GetCreatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *Tweet) GetInReplyToTweetId() int64 {
+	if x != nil {
+		return x.InReplyToTweetId
+	}
+	return 0
+}
+
+func (x *Tweet) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *Tweet) GetRetweetOfId() int64 {
+	if x != nil {
+		return x.RetweetOfId
+	}
+	return 0
+}
+
+func (x *Tweet) GetQuotedTweetId() int64 {
+	if x != nil {
+		return x.QuotedTweetId
+	}
+	return 0
+}
+
+func (x *Tweet) GetRetweetCount() uint32 {
+	if x != nil {
+		return x.RetweetCount
+	}
+	return 0
+}
+
+func (x *Tweet) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Tweet) GetOriginal() *Tweet {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *Tweet) GetLikeCount() uint32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *Tweet) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *Tweet) GetEntities() []*TweetEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Tweet) GetEditedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Tweet) GetRevisionCount() uint32 {
+	if x != nil {
+		return x.RevisionCount
+	}
+	return 0
+}
+
+func (x *Tweet) GetMedia() []*Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Tweet) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *Tweet) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *Tweet) GetReplyPolicy() ReplyPolicy {
+	if x != nil {
+		return x.ReplyPolicy
+	}
+	return ReplyPolicy_REPLY_EVERYONE
+}
+
+func (x *Tweet) GetCanReply() bool {
+	if x != nil {
+		return x.CanReply
+	}
+	return false
+}
+
+func (x *Tweet) GetBookmarked() bool {
+	if x != nil {
+		return x.Bookmarked
+	}
+	return false
+}
+
+func (x *Tweet) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+// ScheduledTweet a tweet published at publish_at, drafts have no
+// publish_at and are never published.
+type ScheduledTweet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       int64                `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username     string               `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Content      string               `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	ReplyTo      int64                `protobuf:"varint,5,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	QuoteTweetId int64                `protobuf:"varint,6,opt,name=quote_tweet_id,json=quoteTweetId,proto3" json:"quote_tweet_id,omitempty"`
+	PublishAt    *timestamp.Timestamp `protobuf:"bytes,7,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	CreatedAt    *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// reason of the last publication failure, the tweet is turned into
+	// a draft when its publication fails.
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ScheduledTweet) Reset() {
+	*x = ScheduledTweet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_message_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledTweet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTweet) ProtoMessage() {}
+
+func (x *ScheduledTweet) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_message_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTweet.ProtoReflect.Descriptor instead.
+func (*ScheduledTweet) Descriptor() ([]byte, []int) {
+	return file_tweet_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduledTweet) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledTweet) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ScheduledTweet) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ScheduledTweet) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ScheduledTweet) GetReplyTo() int64 {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return 0
+}
+
+func (x *ScheduledTweet) GetQuoteTweetId() int64 {
+	if x != nil {
+		return x.QuoteTweetId
+	}
+	return 0
+}
+
+func (x *ScheduledTweet) GetPublishAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *ScheduledTweet) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduledTweet) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ScheduledTweet) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// TweetRevision a version of a tweet content, revision 0 is the
+// original content.
+type TweetRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision  uint32               `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Content   string               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *TweetRevision) Reset() {
+	*x = TweetRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_message_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TweetRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TweetRevision) ProtoMessage() {}
+
+func (x *TweetRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_message_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TweetRevision.ProtoReflect.Descriptor instead.
+func (*TweetRevision) Descriptor() ([]byte, []int) {
+	return file_tweet_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TweetRevision) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *TweetRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TweetRevision) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type TweetEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action  Action `protobuf:"varint,1,opt,name=action,proto3,enum=v1.Action" json:"action,omitempty"`
-	TweetId int64  `protobuf:"varint,2,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
-	UserId  int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title   string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Action           Action `protobuf:"varint,1,opt,name=action,proto3,enum=v1.Action" json:"action,omitempty"`
+	TweetId          int64  `protobuf:"varint,2,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+	UserId           int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title            string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	InReplyToTweetId int64  `protobuf:"varint,5,opt,name=in_reply_to_tweet_id,json=inReplyToTweetId,proto3" json:"in_reply_to_tweet_id,omitempty"`
+	InReplyToUserId  int64  `protobuf:"varint,6,opt,name=in_reply_to_user_id,json=inReplyToUserId,proto3" json:"in_reply_to_user_id,omitempty"`
+	// type of the event, TWEET when unset.
+	Type Type `protobuf:"varint,7,opt,name=type,proto3,enum=v1.Type" json:"type,omitempty"`
+	// author of the tweet concerned by a LIKE event.
+	TweetUserId int64 `protobuf:"varint,8,opt,name=tweet_user_id,json=tweetUserId,proto3" json:"tweet_user_id,omitempty"`
+	// users mentioned by a MENTION event.
+	MentionedUserIds []int64 `protobuf:"varint,9,rep,packed,name=mentioned_user_ids,json=mentionedUserIds,proto3" json:"mentioned_user_ids,omitempty"`
+	// users notified of a closed poll.
+	RecipientUserIds []int64 `protobuf:"varint,10,rep,packed,name=recipient_user_ids,json=recipientUserIds,proto3" json:"recipient_user_ids,omitempty"`
+	// visibility of the created tweet, followers are not notified of
+	// MENTIONED_ONLY tweets.
+	Visibility Visibility `protobuf:"varint,11,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
 }
 
 func (x *TweetEvent) Reset() {
 	*x = TweetEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tweet_message_proto_msgTypes[1]
+		mi := &file_tweet_message_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -124,7 +483,7 @@ func (x *TweetEvent) String() string {
 func (*TweetEvent) ProtoMessage() {}
 
 func (x *TweetEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_tweet_message_proto_msgTypes[1]
+	mi := &file_tweet_message_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -137,7 +496,7 @@ func (x *TweetEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TweetEvent.ProtoReflect.Descriptor instead.
 func (*TweetEvent) Descriptor() ([]byte, []int) {
-	return file_tweet_message_proto_rawDescGZIP(), []int{1}
+	return file_tweet_message_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TweetEvent) GetAction() Action {
@@ -168,6 +527,55 @@ func (x *TweetEvent) GetTitle() string {
 	return ""
 }
 
+func (x *TweetEvent) GetInReplyToTweetId() int64 {
+	if x != nil {
+		return x.InReplyToTweetId
+	}
+	return 0
+}
+
+func (x *TweetEvent) GetInReplyToUserId() int64 {
+	if x != nil {
+		return x.InReplyToUserId
+	}
+	return 0
+}
+
+func (x *TweetEvent) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type_UNKNOWNE_TYPE
+}
+
+func (x *TweetEvent) GetTweetUserId() int64 {
+	if x != nil {
+		return x.TweetUserId
+	}
+	return 0
+}
+
+func (x *TweetEvent) GetMentionedUserIds() []int64 {
+	if x != nil {
+		return x.MentionedUserIds
+	}
+	return nil
+}
+
+func (x *TweetEvent) GetRecipientUserIds() []int64 {
+	if x != nil {
+		return x.RecipientUserIds
+	}
+	return nil
+}
+
+func (x *TweetEvent) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_PUBLIC
+}
+
 var File_tweet_message_proto protoreflect.FileDescriptor
 
 var file_tweet_message_proto_rawDesc = []byte{
@@ -176,15 +584,98 @@ var file_tweet_message_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x61, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x85, 0x01, 0x0a, 0x05, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x1a, 0x12, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x12, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xca, 0x06,
+	0x0a, 0x05, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x14, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x54, 0x77,
+	0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0d, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x4f, 0x66,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x77, 0x65,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x6c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c,
+	0x6c, 0x12, 0x2e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x32, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0xf7, 0x02, 0x0a, 0x0e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x12, 0x24, 0x0a, 0x0e, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7a, 0x0a, 0x0a, 0x54, 0x77, 0x65, 0x65,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x0d, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa6, 0x03, 0x0a, 0x0a, 0x54, 0x77, 0x65, 0x65,
 	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77,
@@ -192,8 +683,26 @@ var file_tweet_message_proto_rawDesc = []byte{
 	0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
 	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x14, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x13, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x77, 0x65, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x65,
+	0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x10, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x03, 0x52, 0x10,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x12, 0x2e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -208,21 +717,42 @@ func file_tweet_message_proto_rawDescGZIP() []byte {
 	return file_tweet_message_proto_rawDescData
 }
 
-var file_tweet_message_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tweet_message_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_tweet_message_proto_goTypes = []interface{}{
 	(*Tweet)(nil),               // 0: v1.Tweet
-	(*TweetEvent)(nil),          // 1: v1.TweetEvent
-	(*timestamp.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(Action)(0),                 // 3: v1.Action
+	(*ScheduledTweet)(nil),      // 1: v1.ScheduledTweet
+	(*TweetRevision)(nil),       // 2: v1.TweetRevision
+	(*TweetEvent)(nil),          // 3: v1.TweetEvent
+	(*timestamp.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*TweetEntity)(nil),         // 5: v1.TweetEntity
+	(*Media)(nil),               // 6: v1.Media
+	(*Poll)(nil),                // 7: v1.Poll
+	(Visibility)(0),             // 8: v1.Visibility
+	(ReplyPolicy)(0),            // 9: v1.ReplyPolicy
+	(Action)(0),                 // 10: v1.Action
+	(Type)(0),                   // 11: v1.Type
 }
 var file_tweet_message_proto_depIdxs = []int32{
-	2, // 0: v1.Tweet.created_at:type_name -> google.protobuf.Timestamp
-	3, // 1: v1.TweetEvent.action:type_name -> v1.Action
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4,  // 0: v1.Tweet.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: v1.Tweet.original:type_name -> v1.Tweet
+	5,  // 2: v1.Tweet.entities:type_name -> v1.TweetEntity
+	4,  // 3: v1.Tweet.edited_at:type_name -> google.protobuf.Timestamp
+	6,  // 4: v1.Tweet.media:type_name -> v1.Media
+	7,  // 5: v1.Tweet.poll:type_name -> v1.Poll
+	8,  // 6: v1.Tweet.visibility:type_name -> v1.Visibility
+	9,  // 7: v1.Tweet.reply_policy:type_name -> v1.ReplyPolicy
+	4,  // 8: v1.ScheduledTweet.publish_at:type_name -> google.protobuf.Timestamp
+	4,  // 9: v1.ScheduledTweet.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 10: v1.ScheduledTweet.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 11: v1.TweetRevision.created_at:type_name -> google.protobuf.Timestamp
+	10, // 12: v1.TweetEvent.action:type_name -> v1.Action
+	11, // 13: v1.TweetEvent.type:type_name -> v1.Type
+	8,  // 14: v1.TweetEvent.visibility:type_name -> v1.Visibility
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_tweet_message_proto_init() }
@@ -231,6 +761,11 @@ func file_tweet_message_proto_init() {
 		return
 	}
 	file_action_message_proto_init()
+	file_type_message_proto_init()
+	file_entity_message_proto_init()
+	file_media_message_proto_init()
+	file_poll_message_proto_init()
+	file_visibility_message_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_tweet_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Tweet); i {
@@ -245,6 +780,30 @@ func file_tweet_message_proto_init() {
 			}
 		}
 		file_tweet_message_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledTweet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_message_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TweetRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_message_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TweetEvent); i {
 			case 0:
 				return &v.state
@@ -263,7 +822,7 @@ func file_tweet_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tweet_message_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -9,6 +9,7 @@ package pb
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -35,7 +36,14 @@ type CreateTweetRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Content      string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ReplyTo      int64  `protobuf:"varint,3,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	QuoteTweetId int64  `protobuf:"varint,4,opt,name=quote_tweet_id,json=quoteTweetId,proto3" json:"quote_tweet_id,omitempty"`
+	// uploaded media attached to the tweet, at most 4.
+	MediaIds    []int64     `protobuf:"varint,5,rep,packed,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
+	Poll        *NewPoll    `protobuf:"bytes,6,opt,name=poll,proto3" json:"poll,omitempty"`
+	Visibility  Visibility  `protobuf:"varint,7,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
+	ReplyPolicy ReplyPolicy `protobuf:"varint,8,opt,name=reply_policy,json=replyPolicy,proto3,enum=v1.ReplyPolicy" json:"reply_policy,omitempty"`
 }
 
 func (x *CreateTweetRequest) Reset() {
@@ -77,6 +85,104 @@ func (x *CreateTweetRequest) GetContent() string {
 	return ""
 }
 
+func (x *CreateTweetRequest) GetReplyTo() int64 {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return 0
+}
+
+func (x *CreateTweetRequest) GetQuoteTweetId() int64 {
+	if x != nil {
+		return x.QuoteTweetId
+	}
+	return 0
+}
+
+func (x *CreateTweetRequest) GetMediaIds() []int64 {
+	if x != nil {
+		return x.MediaIds
+	}
+	return nil
+}
+
+func (x *CreateTweetRequest) GetPoll() *NewPoll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *CreateTweetRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *CreateTweetRequest) GetReplyPolicy() ReplyPolicy {
+	if x != nil {
+		return x.ReplyPolicy
+	}
+	return ReplyPolicy_REPLY_EVERYONE
+}
+
+// NewPoll poll created with a tweet, 2 to 4 options.
+type NewPoll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options  []string             `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	ClosesAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+}
+
+func (x *NewPoll) Reset() {
+	*x = NewPoll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewPoll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPoll) ProtoMessage() {}
+
+func (x *NewPoll) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPoll.ProtoReflect.Descriptor instead.
+func (*NewPoll) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NewPoll) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *NewPoll) GetClosesAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
 // create tweet response
 type CreateTweetResponse struct {
 	state         protoimpl.MessageState
@@ -89,7 +195,7 @@ type CreateTweetResponse struct {
 func (x *CreateTweetResponse) Reset() {
 	*x = CreateTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tweet_service_proto_msgTypes[1]
+		mi := &file_tweet_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -102,7 +208,7 @@ func (x *CreateTweetResponse) String() string {
 func (*CreateTweetResponse) ProtoMessage() {}
 
 func (x *CreateTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tweet_service_proto_msgTypes[1]
+	mi := &file_tweet_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -115,7 +221,7 @@ func (x *CreateTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTweetResponse.ProtoReflect.Descriptor instead.
 func (*CreateTweetResponse) Descriptor() ([]byte, []int) {
-	return file_tweet_service_proto_rawDescGZIP(), []int{1}
+	return file_tweet_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateTweetResponse) GetId() int64 {
@@ -138,7 +244,7 @@ type UpdateTweetRequest struct {
 func (x *UpdateTweetRequest) Reset() {
 	*x = UpdateTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tweet_service_proto_msgTypes[2]
+		mi := &file_tweet_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -151,7 +257,7 @@ func (x *UpdateTweetRequest) String() string {
 func (*UpdateTweetRequest) ProtoMessage() {}
 
 func (x *UpdateTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tweet_service_proto_msgTypes[2]
+	mi := &file_tweet_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,7 +270,7 @@ func (x *UpdateTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTweetRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTweetRequest) Descriptor() ([]byte, []int) {
-	return file_tweet_service_proto_rawDescGZIP(), []int{2}
+	return file_tweet_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpdateTweetRequest) GetId() int64 {
@@ -191,7 +297,7 @@ type UpdateTweetResponse struct {
 func (x *UpdateTweetResponse) Reset() {
 	*x = UpdateTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tweet_service_proto_msgTypes[3]
+		mi := &file_tweet_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -204,7 +310,7 @@ func (x *UpdateTweetResponse) String() string {
 func (*UpdateTweetResponse) ProtoMessage() {}
 
 func (x *UpdateTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tweet_service_proto_msgTypes[3]
+	mi := &file_tweet_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,7 +323,7 @@ func (x *UpdateTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTweetResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTweetResponse) Descriptor() ([]byte, []int) {
-	return file_tweet_service_proto_rawDescGZIP(), []int{3}
+	return file_tweet_service_proto_rawDescGZIP(), []int{4}
 }
 
 // Get tweet request
@@ -232,7 +338,7 @@ type GetTweetRequest struct {
 func (x *GetTweetRequest) Reset() {
 	*x = GetTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tweet_service_proto_msgTypes[4]
+		mi := &file_tweet_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -245,7 +351,7 @@ func (x *GetTweetRequest) String() string {
 func (*GetTweetRequest) ProtoMessage() {}
 
 func (x *GetTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tweet_service_proto_msgTypes[4]
+	mi := &file_tweet_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +364,7 @@ func (x *GetTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetRequest.ProtoReflect.Descriptor instead.
 func (*GetTweetRequest) Descriptor() ([]byte, []int) {
-	return file_tweet_service_proto_rawDescGZIP(), []int{4}
+	return file_tweet_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetTweetRequest) GetId() int64 {
@@ -280,7 +386,7 @@ type GetTweetResponse struct {
 func (x *GetTweetResponse) Reset() {
 	*x = GetTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tweet_service_proto_msgTypes[5]
+		mi := &file_tweet_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -293,7 +399,7 @@ func (x *GetTweetResponse) String() string {
 func (*GetTweetResponse) ProtoMessage() {}
 
 func (x *GetTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tweet_service_proto_msgTypes[5]
+	mi := &file_tweet_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +412,7 @@ func (x *GetTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetResponse.ProtoReflect.Descriptor instead.
 func (*GetTweetResponse) Descriptor() ([]byte, []int) {
-	return file_tweet_service_proto_rawDescGZIP(), []int{5}
+	return file_tweet_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetTweetResponse) GetTweet() *Tweet {
@@ -328,7 +434,7 @@ type DeleteTweetRequest struct {
 func (x *DeleteTweetRequest) Reset() {
 	*x = DeleteTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tweet_service_proto_msgTypes[6]
+		mi := &file_tweet_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -341,7 +447,7 @@ func (x *DeleteTweetRequest) String() string {
 func (*DeleteTweetRequest) ProtoMessage() {}
 
 func (x *DeleteTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tweet_service_proto_msgTypes[6]
+	mi := &file_tweet_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,7 +460,7 @@ func (x *DeleteTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTweetRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTweetRequest) Descriptor() ([]byte, []int) {
-	return file_tweet_service_proto_rawDescGZIP(), []int{6}
+	return file_tweet_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteTweetRequest) GetId() int64 {
@@ -374,7 +480,7 @@ type DeleteTweetResponse struct {
 func (x *DeleteTweetResponse) Reset() {
 	*x = DeleteTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tweet_service_proto_msgTypes[7]
+		mi := &file_tweet_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -387,7 +493,7 @@ func (x *DeleteTweetResponse) String() string {
 func (*DeleteTweetResponse) ProtoMessage() {}
 
 func (x *DeleteTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tweet_service_proto_msgTypes[7]
+	mi := &file_tweet_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,24 +506,28 @@ func (x *DeleteTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTweetResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTweetResponse) Descriptor() ([]byte, []int) {
-	return file_tweet_service_proto_rawDescGZIP(), []int{7}
+	return file_tweet_service_proto_rawDescGZIP(), []int{8}
 }
 
-// Delete tweet request
+// List tweets request, tweets are listed newest first. page_token
+// continues a previous listing, max_id keeps tweets with a lower or equal
+// id and since_id tweets with a greater id.
 type ListTweetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	UserId    int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit     int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	MaxId     int64  `protobuf:"varint,5,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	SinceId   int64  `protobuf:"varint,6,opt,name=since_id,json=sinceId,proto3" json:"since_id,omitempty"`
 }
 
 func (x *ListTweetRequest) Reset() {
 	*x = ListTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tweet_service_proto_msgTypes[8]
+		mi := &file_tweet_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -430,7 +540,7 @@ func (x *ListTweetRequest) String() string {
 func (*ListTweetRequest) ProtoMessage() {}
 
 func (x *ListTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tweet_service_proto_msgTypes[8]
+	mi := &file_tweet_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +553,7 @@ func (x *ListTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTweetRequest.ProtoReflect.Descriptor instead.
 func (*ListTweetRequest) Descriptor() ([]byte, []int) {
-	return file_tweet_service_proto_rawDescGZIP(), []int{8}
+	return file_tweet_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListTweetRequest) GetUserId() int64 {
@@ -460,26 +570,42 @@ func (x *ListTweetRequest) GetLimit() int32 {
 	return 0
 }
 
-func (x *ListTweetRequest) GetOffset() int32 {
+func (x *ListTweetRequest) GetPageToken() string {
 	if x != nil {
-		return x.Offset
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTweetRequest) GetMaxId() int64 {
+	if x != nil {
+		return x.MaxId
 	}
 	return 0
 }
 
-// Delete tweet response
+func (x *ListTweetRequest) GetSinceId() int64 {
+	if x != nil {
+		return x.SinceId
+	}
+	return 0
+}
+
+// List tweets response, next_page_token continues the listing after
+// this tweet.
 type ListTweetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tweet *Tweet `protobuf:"bytes,1,opt,name=tweet,proto3" json:"tweet,omitempty"`
+	Tweet         *Tweet `protobuf:"bytes,1,opt,name=tweet,proto3" json:"tweet,omitempty"`
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListTweetResponse) Reset() {
 	*x = ListTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tweet_service_proto_msgTypes[9]
+		mi := &file_tweet_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -492,7 +618,7 @@ func (x *ListTweetResponse) String() string {
 func (*ListTweetResponse) ProtoMessage() {}
 
 func (x *ListTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tweet_service_proto_msgTypes[9]
+	mi := &file_tweet_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +631,7 @@ func (x *ListTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTweetResponse.ProtoReflect.Descriptor instead.
 func (*ListTweetResponse) Descriptor() ([]byte, []int) {
-	return file_tweet_service_proto_rawDescGZIP(), []int{9}
+	return file_tweet_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListTweetResponse) GetTweet() *Tweet {
@@ -515,489 +641,4942 @@ func (x *ListTweetResponse) GetTweet() *Tweet {
 	return nil
 }
 
-var File_tweet_service_proto protoreflect.FileDescriptor
+func (x *ListTweetResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-var file_tweet_service_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x1a, 0x13, 0x74, 0x77, 0x65, 0x65, 0x74,
-	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2e,
-	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x25,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x33, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x05, 0x74,
-	0x77, 0x65, 0x65, 0x74, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77,
-	0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x59, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x34, 0x0a, 0x11,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x05, 0x74, 0x77, 0x65,
-	0x65, 0x74, 0x32, 0xb2, 0x02, 0x0a, 0x0c, 0x74, 0x77, 0x65, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3b, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77,
-	0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a,
-	0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x03, 0x47, 0x65,
-	0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37,
-	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Retweet request
+type RetweetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
 }
 
-var (
-	file_tweet_service_proto_rawDescOnce sync.Once
-	file_tweet_service_proto_rawDescData = file_tweet_service_proto_rawDesc
-)
+func (x *RetweetRequest) Reset() {
+	*x = RetweetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_tweet_service_proto_rawDescGZIP() []byte {
-	file_tweet_service_proto_rawDescOnce.Do(func() {
-		file_tweet_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_tweet_service_proto_rawDescData)
-	})
-	return file_tweet_service_proto_rawDescData
+func (x *RetweetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_tweet_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_tweet_service_proto_goTypes = []interface{}{
-	(*CreateTweetRequest)(nil),  // 0: v1.CreateTweetRequest
-	(*CreateTweetResponse)(nil), // 1: v1.CreateTweetResponse
-	(*UpdateTweetRequest)(nil),  // 2: v1.UpdateTweetRequest
-	(*UpdateTweetResponse)(nil), // 3: v1.UpdateTweetResponse
-	(*GetTweetRequest)(nil),     // 4: v1.GetTweetRequest
-	(*GetTweetResponse)(nil),    // 5: v1.GetTweetResponse
-	(*DeleteTweetRequest)(nil),  // 6: v1.DeleteTweetRequest
-	(*DeleteTweetResponse)(nil), // 7: v1.DeleteTweetResponse
-	(*ListTweetRequest)(nil),    // 8: v1.ListTweetRequest
-	(*ListTweetResponse)(nil),   // 9: v1.ListTweetResponse
-	(*Tweet)(nil),               // 10: v1.Tweet
+func (*RetweetRequest) ProtoMessage() {}
+
+func (x *RetweetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_tweet_service_proto_depIdxs = []int32{
-	10, // 0: v1.GetTweetResponse.tweet:type_name -> v1.Tweet
-	10, // 1: v1.ListTweetResponse.tweet:type_name -> v1.Tweet
-	0,  // 2: v1.tweetService.Create:input_type -> v1.CreateTweetRequest
-	2,  // 3: v1.tweetService.Update:input_type -> v1.UpdateTweetRequest
-	6,  // 4: v1.tweetService.Delete:input_type -> v1.DeleteTweetRequest
-	4,  // 5: v1.tweetService.Get:input_type -> v1.GetTweetRequest
-	8,  // 6: v1.tweetService.List:input_type -> v1.ListTweetRequest
-	1,  // 7: v1.tweetService.Create:output_type -> v1.CreateTweetResponse
-	3,  // 8: v1.tweetService.Update:output_type -> v1.UpdateTweetResponse
-	7,  // 9: v1.tweetService.Delete:output_type -> v1.DeleteTweetResponse
-	5,  // 10: v1.tweetService.Get:output_type -> v1.GetTweetResponse
-	9,  // 11: v1.tweetService.List:output_type -> v1.ListTweetResponse
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use RetweetRequest.ProtoReflect.Descriptor instead.
+func (*RetweetRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{11}
 }
 
-func init() { file_tweet_service_proto_init() }
-func file_tweet_service_proto_init() {
-	if File_tweet_service_proto != nil {
-		return
+func (x *RetweetRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
 	}
-	file_tweet_message_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_tweet_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTweetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tweet_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTweetResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tweet_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTweetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tweet_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTweetResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+// Retweet response
+type RetweetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RetweetResponse) Reset() {
+	*x = RetweetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetweetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetweetResponse) ProtoMessage() {}
+
+func (x *RetweetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_tweet_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTweetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tweet_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTweetResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tweet_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTweetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetweetResponse.ProtoReflect.Descriptor instead.
+func (*RetweetResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RetweetResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Undo retweet request
+type UndoRetweetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+}
+
+func (x *UndoRetweetRequest) Reset() {
+	*x = UndoRetweetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoRetweetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoRetweetRequest) ProtoMessage() {}
+
+func (x *UndoRetweetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_tweet_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTweetResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoRetweetRequest.ProtoReflect.Descriptor instead.
+func (*UndoRetweetRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UndoRetweetRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
+
+// Undo retweet response
+type UndoRetweetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UndoRetweetResponse) Reset() {
+	*x = UndoRetweetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoRetweetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoRetweetResponse) ProtoMessage() {}
+
+func (x *UndoRetweetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_tweet_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTweetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoRetweetResponse.ProtoReflect.Descriptor instead.
+func (*UndoRetweetResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{14}
+}
+
+// List hashtag tweets request
+type ListHashtagTweetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashtag string `protobuf:"bytes,1,opt,name=hashtag,proto3" json:"hashtag,omitempty"`
+	Limit   int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset  int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ListHashtagTweetsRequest) Reset() {
+	*x = ListHashtagTweetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHashtagTweetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHashtagTweetsRequest) ProtoMessage() {}
+
+func (x *ListHashtagTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_tweet_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTweetResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHashtagTweetsRequest.ProtoReflect.Descriptor instead.
+func (*ListHashtagTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListHashtagTweetsRequest) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+func (x *ListHashtagTweetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListHashtagTweetsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Like request
+type LikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+}
+
+func (x *LikeRequest) Reset() {
+	*x = LikeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeRequest) ProtoMessage() {}
+
+func (x *LikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_tweet_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   10,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_tweet_service_proto_goTypes,
-		DependencyIndexes: file_tweet_service_proto_depIdxs,
-		MessageInfos:      file_tweet_service_proto_msgTypes,
-	}.Build()
-	File_tweet_service_proto = out.File
-	file_tweet_service_proto_rawDesc = nil
-	file_tweet_service_proto_goTypes = nil
-	file_tweet_service_proto_depIdxs = nil
+	return mi.MessageOf(x)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+// Deprecated: Use LikeRequest.ProtoReflect.Descriptor instead.
+func (*LikeRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{16}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (x *LikeRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
 
-// TweetServiceClient is the client API for TweetService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type TweetServiceClient interface {
-	// create a tweet service
-	Create(ctx context.Context, in *CreateTweetRequest, opts ...grpc.CallOption) (*CreateTweetResponse, error)
-	// update a tweet service
-	Update(ctx context.Context, in *UpdateTweetRequest, opts ...grpc.CallOption) (*UpdateTweetResponse, error)
-	// delete a tweet service
-	Delete(ctx context.Context, in *DeleteTweetRequest, opts ...grpc.CallOption) (*DeleteTweetResponse, error)
-	// get a tweet service
-	Get(ctx context.Context, in *GetTweetRequest, opts ...grpc.CallOption) (*GetTweetResponse, error)
-	// list a tweet service
-	List(ctx context.Context, in *ListTweetRequest, opts ...grpc.CallOption) (TweetService_ListClient, error)
+// Like response
+type LikeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type tweetServiceClient struct {
-	cc grpc.ClientConnInterface
+func (x *LikeResponse) Reset() {
+	*x = LikeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeResponse) ProtoMessage() {}
+
+func (x *LikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeResponse.ProtoReflect.Descriptor instead.
+func (*LikeResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{17}
+}
+
+// Unlike request
+type UnlikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+}
+
+func (x *UnlikeRequest) Reset() {
+	*x = UnlikeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeRequest) ProtoMessage() {}
+
+func (x *UnlikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnlikeRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
+
+// Unlike response
+type UnlikeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnlikeResponse) Reset() {
+	*x = UnlikeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeResponse) ProtoMessage() {}
+
+func (x *UnlikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeResponse.ProtoReflect.Descriptor instead.
+func (*UnlikeResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{19}
+}
+
+// List likers request
+type ListLikersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+	Limit   int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset  int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ListLikersRequest) Reset() {
+	*x = ListLikersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLikersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLikersRequest) ProtoMessage() {}
+
+func (x *ListLikersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLikersRequest.ProtoReflect.Descriptor instead.
+func (*ListLikersRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListLikersRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
+
+func (x *ListLikersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListLikersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// List likers response
+type ListLikersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ListLikersResponse) Reset() {
+	*x = ListLikersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLikersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLikersResponse) ProtoMessage() {}
+
+func (x *ListLikersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLikersResponse.ProtoReflect.Descriptor instead.
+func (*ListLikersResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListLikersResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Get conversation request
+type GetConversationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+}
+
+func (x *GetConversationRequest) Reset() {
+	*x = GetConversationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationRequest) ProtoMessage() {}
+
+func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetConversationRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
+
+// Get conversation response, tweets are sent depth first
+// starting from the conversation root.
+type GetConversationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tweet *Tweet `protobuf:"bytes,1,opt,name=tweet,proto3" json:"tweet,omitempty"`
+	Depth int32  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (x *GetConversationResponse) Reset() {
+	*x = GetConversationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationResponse) ProtoMessage() {}
+
+func (x *GetConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetConversationResponse) GetTweet() *Tweet {
+	if x != nil {
+		return x.Tweet
+	}
+	return nil
+}
+
+func (x *GetConversationResponse) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// Search tweets request, query syntax: words, "exact phrases",
+// from:username, #hashtag, since:2006-01-02, until:2006-01-02, words,
+// phrases, from: and hashtags are excluded when prefixed by -.
+type SearchTweetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query     string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit     int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *SearchTweetsRequest) Reset() {
+	*x = SearchTweetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchTweetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTweetsRequest) ProtoMessage() {}
+
+func (x *SearchTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTweetsRequest.ProtoReflect.Descriptor instead.
+func (*SearchTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchTweetsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTweetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchTweetsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Search tweets response, tweets are sent by relevance, next_page_token
+// continues the search after this tweet.
+type SearchTweetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tweet         *Tweet  `protobuf:"bytes,1,opt,name=tweet,proto3" json:"tweet,omitempty"`
+	Rank          float32 `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	NextPageToken string  `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *SearchTweetsResponse) Reset() {
+	*x = SearchTweetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchTweetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTweetsResponse) ProtoMessage() {}
+
+func (x *SearchTweetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTweetsResponse.ProtoReflect.Descriptor instead.
+func (*SearchTweetsResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SearchTweetsResponse) GetTweet() *Tweet {
+	if x != nil {
+		return x.Tweet
+	}
+	return nil
+}
+
+func (x *SearchTweetsResponse) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchTweetsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Stream tweets request, tweets matching any rule are streamed,
+// a keyword matches when all its words appear in the tweet.
+type StreamTweetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keywords  []string `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Hashtags  []string `protobuf:"bytes,2,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
+	AuthorIds []int64  `protobuf:"varint,3,rep,packed,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+}
+
+func (x *StreamTweetsRequest) Reset() {
+	*x = StreamTweetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamTweetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTweetsRequest) ProtoMessage() {}
+
+func (x *StreamTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTweetsRequest.ProtoReflect.Descriptor instead.
+func (*StreamTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StreamTweetsRequest) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *StreamTweetsRequest) GetHashtags() []string {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+func (x *StreamTweetsRequest) GetAuthorIds() []int64 {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+// Stream tweets response, matched_rules are formatted as keyword:words,
+// hashtag:tag or author:id.
+type StreamTweetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tweet        *Tweet   `protobuf:"bytes,1,opt,name=tweet,proto3" json:"tweet,omitempty"`
+	MatchedRules []string `protobuf:"bytes,2,rep,name=matched_rules,json=matchedRules,proto3" json:"matched_rules,omitempty"`
+}
+
+func (x *StreamTweetsResponse) Reset() {
+	*x = StreamTweetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamTweetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTweetsResponse) ProtoMessage() {}
+
+func (x *StreamTweetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTweetsResponse.ProtoReflect.Descriptor instead.
+func (*StreamTweetsResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StreamTweetsResponse) GetTweet() *Tweet {
+	if x != nil {
+		return x.Tweet
+	}
+	return nil
+}
+
+func (x *StreamTweetsResponse) GetMatchedRules() []string {
+	if x != nil {
+		return x.MatchedRules
+	}
+	return nil
+}
+
+// List revisions request
+type ListRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+}
+
+func (x *ListRevisionsRequest) Reset() {
+	*x = ListRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListRevisionsRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
+
+// List revisions response, revisions are sent oldest first, the last one
+// is the current content.
+type ListRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision *TweetRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *ListRevisionsResponse) Reset() {
+	*x = ListRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsResponse) ProtoMessage() {}
+
+func (x *ListRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListRevisionsResponse) GetRevision() *TweetRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// Create scheduled tweet request, a draft is saved when publish_at
+// is not set.
+type CreateScheduledTweetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content      string               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ReplyTo      int64                `protobuf:"varint,2,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	QuoteTweetId int64                `protobuf:"varint,3,opt,name=quote_tweet_id,json=quoteTweetId,proto3" json:"quote_tweet_id,omitempty"`
+	PublishAt    *timestamp.Timestamp `protobuf:"bytes,4,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+}
+
+func (x *CreateScheduledTweetRequest) Reset() {
+	*x = CreateScheduledTweetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateScheduledTweetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledTweetRequest) ProtoMessage() {}
+
+func (x *CreateScheduledTweetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledTweetRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduledTweetRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateScheduledTweetRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateScheduledTweetRequest) GetReplyTo() int64 {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return 0
+}
+
+func (x *CreateScheduledTweetRequest) GetQuoteTweetId() int64 {
+	if x != nil {
+		return x.QuoteTweetId
+	}
+	return 0
+}
+
+func (x *CreateScheduledTweetRequest) GetPublishAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+// Create scheduled tweet response
+type CreateScheduledTweetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledTweet *ScheduledTweet `protobuf:"bytes,1,opt,name=scheduled_tweet,json=scheduledTweet,proto3" json:"scheduled_tweet,omitempty"`
+}
+
+func (x *CreateScheduledTweetResponse) Reset() {
+	*x = CreateScheduledTweetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateScheduledTweetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledTweetResponse) ProtoMessage() {}
+
+func (x *CreateScheduledTweetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledTweetResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduledTweetResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateScheduledTweetResponse) GetScheduledTweet() *ScheduledTweet {
+	if x != nil {
+		return x.ScheduledTweet
+	}
+	return nil
+}
+
+// List scheduled tweets request, drafts are listed when drafts is true.
+type ListScheduledTweetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drafts bool `protobuf:"varint,1,opt,name=drafts,proto3" json:"drafts,omitempty"`
+}
+
+func (x *ListScheduledTweetsRequest) Reset() {
+	*x = ListScheduledTweetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledTweetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTweetsRequest) ProtoMessage() {}
+
+func (x *ListScheduledTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTweetsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListScheduledTweetsRequest) GetDrafts() bool {
+	if x != nil {
+		return x.Drafts
+	}
+	return false
+}
+
+// List scheduled tweets response
+type ListScheduledTweetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledTweet *ScheduledTweet `protobuf:"bytes,1,opt,name=scheduled_tweet,json=scheduledTweet,proto3" json:"scheduled_tweet,omitempty"`
+}
+
+func (x *ListScheduledTweetsResponse) Reset() {
+	*x = ListScheduledTweetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledTweetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTweetsResponse) ProtoMessage() {}
+
+func (x *ListScheduledTweetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTweetsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTweetsResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListScheduledTweetsResponse) GetScheduledTweet() *ScheduledTweet {
+	if x != nil {
+		return x.ScheduledTweet
+	}
+	return nil
+}
+
+// Update scheduled tweet request, the content is kept when empty and the
+// tweet becomes a draft when publish_at is not set.
+type UpdateScheduledTweetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content   string               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	PublishAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+}
+
+func (x *UpdateScheduledTweetRequest) Reset() {
+	*x = UpdateScheduledTweetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateScheduledTweetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduledTweetRequest) ProtoMessage() {}
+
+func (x *UpdateScheduledTweetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduledTweetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduledTweetRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateScheduledTweetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateScheduledTweetRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateScheduledTweetRequest) GetPublishAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+// Update scheduled tweet response
+type UpdateScheduledTweetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledTweet *ScheduledTweet `protobuf:"bytes,1,opt,name=scheduled_tweet,json=scheduledTweet,proto3" json:"scheduled_tweet,omitempty"`
+}
+
+func (x *UpdateScheduledTweetResponse) Reset() {
+	*x = UpdateScheduledTweetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateScheduledTweetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduledTweetResponse) ProtoMessage() {}
+
+func (x *UpdateScheduledTweetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduledTweetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduledTweetResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateScheduledTweetResponse) GetScheduledTweet() *ScheduledTweet {
+	if x != nil {
+		return x.ScheduledTweet
+	}
+	return nil
+}
+
+// Cancel scheduled tweet request
+type CancelScheduledTweetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelScheduledTweetRequest) Reset() {
+	*x = CancelScheduledTweetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledTweetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTweetRequest) ProtoMessage() {}
+
+func (x *CancelScheduledTweetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTweetRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTweetRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CancelScheduledTweetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Cancel scheduled tweet response
+type CancelScheduledTweetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelScheduledTweetResponse) Reset() {
+	*x = CancelScheduledTweetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledTweetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTweetResponse) ProtoMessage() {}
+
+func (x *CancelScheduledTweetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTweetResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTweetResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{37}
+}
+
+// Upload media request, the content is sent in chunks.
+type UploadMediaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *UploadMediaRequest) Reset() {
+	*x = UploadMediaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaRequest) ProtoMessage() {}
+
+func (x *UploadMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaRequest.ProtoReflect.Descriptor instead.
+func (*UploadMediaRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UploadMediaRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Upload media response
+type UploadMediaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Media *Media `protobuf:"bytes,1,opt,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *UploadMediaResponse) Reset() {
+	*x = UploadMediaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaResponse) ProtoMessage() {}
+
+func (x *UploadMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaResponse.ProtoReflect.Descriptor instead.
+func (*UploadMediaResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UploadMediaResponse) GetMedia() *Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// Vote request, option is the chosen option position.
+type VoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+	Option  int32 `protobuf:"varint,2,opt,name=option,proto3" json:"option,omitempty"`
+}
+
+func (x *VoteRequest) Reset() {
+	*x = VoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteRequest) ProtoMessage() {}
+
+func (x *VoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
+func (*VoteRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VoteRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
+
+func (x *VoteRequest) GetOption() int32 {
+	if x != nil {
+		return x.Option
+	}
+	return 0
+}
+
+// Vote response
+type VoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Poll *Poll `protobuf:"bytes,1,opt,name=poll,proto3" json:"poll,omitempty"`
+}
+
+func (x *VoteResponse) Reset() {
+	*x = VoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteResponse) ProtoMessage() {}
+
+func (x *VoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteResponse.ProtoReflect.Descriptor instead.
+func (*VoteResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VoteResponse) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+// Bookmark request, bookmarking a bookmarked tweet moves it to folder_id,
+// the bookmark is not in a folder when folder_id is 0.
+type BookmarkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId  int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+	FolderId int64 `protobuf:"varint,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+}
+
+func (x *BookmarkRequest) Reset() {
+	*x = BookmarkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkRequest) ProtoMessage() {}
+
+func (x *BookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkRequest.ProtoReflect.Descriptor instead.
+func (*BookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BookmarkRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
+
+func (x *BookmarkRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+// Bookmark response
+type BookmarkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BookmarkResponse) Reset() {
+	*x = BookmarkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkResponse) ProtoMessage() {}
+
+func (x *BookmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkResponse.ProtoReflect.Descriptor instead.
+func (*BookmarkResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{43}
+}
+
+// Unbookmark request
+type UnbookmarkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+}
+
+func (x *UnbookmarkRequest) Reset() {
+	*x = UnbookmarkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbookmarkRequest) ProtoMessage() {}
+
+func (x *UnbookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbookmarkRequest.ProtoReflect.Descriptor instead.
+func (*UnbookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UnbookmarkRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
+
+// Unbookmark response
+type UnbookmarkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnbookmarkResponse) Reset() {
+	*x = UnbookmarkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbookmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbookmarkResponse) ProtoMessage() {}
+
+func (x *UnbookmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbookmarkResponse.ProtoReflect.Descriptor instead.
+func (*UnbookmarkResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{45}
+}
+
+// List bookmarks request, all bookmarks are listed when folder_id is 0.
+type ListBookmarksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolderId int64 `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Limit    int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset   int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ListBookmarksRequest) Reset() {
+	*x = ListBookmarksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksRequest) ProtoMessage() {}
+
+func (x *ListBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListBookmarksRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *ListBookmarksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBookmarksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// List bookmarks response, tweets are sent most recently bookmarked first.
+type ListBookmarksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tweet *Tweet `protobuf:"bytes,1,opt,name=tweet,proto3" json:"tweet,omitempty"`
+}
+
+func (x *ListBookmarksResponse) Reset() {
+	*x = ListBookmarksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBookmarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksResponse) ProtoMessage() {}
+
+func (x *ListBookmarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksResponse.ProtoReflect.Descriptor instead.
+func (*ListBookmarksResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListBookmarksResponse) GetTweet() *Tweet {
+	if x != nil {
+		return x.Tweet
+	}
+	return nil
+}
+
+// Create bookmark folder request
+type CreateBookmarkFolderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateBookmarkFolderRequest) Reset() {
+	*x = CreateBookmarkFolderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBookmarkFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookmarkFolderRequest) ProtoMessage() {}
+
+func (x *CreateBookmarkFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookmarkFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkFolderRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateBookmarkFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Create bookmark folder response
+type CreateBookmarkFolderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folder *BookmarkFolder `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+}
+
+func (x *CreateBookmarkFolderResponse) Reset() {
+	*x = CreateBookmarkFolderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBookmarkFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookmarkFolderResponse) ProtoMessage() {}
+
+func (x *CreateBookmarkFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookmarkFolderResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkFolderResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateBookmarkFolderResponse) GetFolder() *BookmarkFolder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+// List bookmark folders request
+type ListBookmarkFoldersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBookmarkFoldersRequest) Reset() {
+	*x = ListBookmarkFoldersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBookmarkFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarkFoldersRequest) ProtoMessage() {}
+
+func (x *ListBookmarkFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarkFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarkFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{50}
+}
+
+// List bookmark folders response
+type ListBookmarkFoldersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folder *BookmarkFolder `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+}
+
+func (x *ListBookmarkFoldersResponse) Reset() {
+	*x = ListBookmarkFoldersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBookmarkFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarkFoldersResponse) ProtoMessage() {}
+
+func (x *ListBookmarkFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarkFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListBookmarkFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListBookmarkFoldersResponse) GetFolder() *BookmarkFolder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+// Delete bookmark folder request, the folder bookmarks are removed.
+type DeleteBookmarkFolderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteBookmarkFolderRequest) Reset() {
+	*x = DeleteBookmarkFolderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBookmarkFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookmarkFolderRequest) ProtoMessage() {}
+
+func (x *DeleteBookmarkFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookmarkFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkFolderRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteBookmarkFolderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Delete bookmark folder response
+type DeleteBookmarkFolderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBookmarkFolderResponse) Reset() {
+	*x = DeleteBookmarkFolderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBookmarkFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookmarkFolderResponse) ProtoMessage() {}
+
+func (x *DeleteBookmarkFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookmarkFolderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkFolderResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{53}
+}
+
+// Pin tweet request, the pinned tweet replaces the previous one.
+type PinTweetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int64 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+}
+
+func (x *PinTweetRequest) Reset() {
+	*x = PinTweetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinTweetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinTweetRequest) ProtoMessage() {}
+
+func (x *PinTweetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinTweetRequest.ProtoReflect.Descriptor instead.
+func (*PinTweetRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PinTweetRequest) GetTweetId() int64 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
+
+// Pin tweet response
+type PinTweetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PinTweetResponse) Reset() {
+	*x = PinTweetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinTweetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinTweetResponse) ProtoMessage() {}
+
+func (x *PinTweetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinTweetResponse.ProtoReflect.Descriptor instead.
+func (*PinTweetResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{55}
+}
+
+// Unpin tweet request
+type UnpinTweetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnpinTweetRequest) Reset() {
+	*x = UnpinTweetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinTweetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinTweetRequest) ProtoMessage() {}
+
+func (x *UnpinTweetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinTweetRequest.ProtoReflect.Descriptor instead.
+func (*UnpinTweetRequest) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{56}
+}
+
+// Unpin tweet response
+type UnpinTweetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnpinTweetResponse) Reset() {
+	*x = UnpinTweetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tweet_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinTweetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinTweetResponse) ProtoMessage() {}
+
+func (x *UnpinTweetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweet_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinTweetResponse.ProtoReflect.Descriptor instead.
+func (*UnpinTweetResponse) Descriptor() ([]byte, []int) {
+	return file_tweet_service_proto_rawDescGZIP(), []int{57}
+}
+
+var File_tweet_service_proto protoreflect.FileDescriptor
+
+var file_tweet_service_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x1a, 0x13, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x13, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91,
+	0x02, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x12, 0x24, 0x0a, 0x0e, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a,
+	0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x12, 0x2e,
+	0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x32,
+	0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0x5c, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x25, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x33, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
+	0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x77, 0x65, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x5c,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x05, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2b, 0x0a, 0x0e,
+	0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x22, 0x21, 0x0a, 0x0f, 0x52, 0x65, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x12,
+	0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x22, 0x15, 0x0a,
+	0x13, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x74, 0x61, 0x67, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x28, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2a, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x22, 0x10,
+	0x0a, 0x0e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x5c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x32,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0x33, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x05, 0x74, 0x77,
+	0x65, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x60, 0x0a, 0x13, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x73, 0x0a, 0x14, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x05, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x6c, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x22, 0x5c,
+	0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x52, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x22,
+	0x46, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x12, 0x24, 0x0a, 0x0e,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0x5b, 0x0a,
+	0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x22, 0x34, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x61, 0x66,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73,
+	0x22, 0x5a, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x77, 0x65,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x0e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x22, 0x82, 0x01, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41,
+	0x74, 0x22, 0x5b, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x0e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x22, 0x2d,
+	0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a,
+	0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a,
+	0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x36, 0x0a, 0x13, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x22, 0x40, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c,
+	0x6c, 0x22, 0x49, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x12, 0x0a, 0x10,
+	0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2e, 0x0a, 0x11, 0x55, 0x6e, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64,
+	0x22, 0x14, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x05, 0x74, 0x77,
+	0x65, 0x65, 0x74, 0x22, 0x31, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x49, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x22, 0x2d, 0x0a, 0x1b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x0f, 0x50, 0x69,
+	0x6e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x50, 0x69, 0x6e, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11,
+	0x55, 0x6e, 0x70, 0x69, 0x6e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf5, 0x0f, 0x0a, 0x0c, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77,
+	0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x32, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x07,
+	0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x74, 0x77, 0x65,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x64, 0x6f, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x04, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x0f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x31, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x11, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65,
+	0x72, 0x73, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x74, 0x61, 0x67, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77,
+	0x65, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12,
+	0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5b, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x77,
+	0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x16, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x28, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x13, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x55, 0x6e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x12, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x50, 0x69, 0x6e, 0x54, 0x77,
+	0x65, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x54, 0x77, 0x65, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
+	0x6e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0a, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x15,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tweet_service_proto_rawDescOnce sync.Once
+	file_tweet_service_proto_rawDescData = file_tweet_service_proto_rawDesc
+)
+
+func file_tweet_service_proto_rawDescGZIP() []byte {
+	file_tweet_service_proto_rawDescOnce.Do(func() {
+		file_tweet_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_tweet_service_proto_rawDescData)
+	})
+	return file_tweet_service_proto_rawDescData
+}
+
+var file_tweet_service_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_tweet_service_proto_goTypes = []interface{}{
+	(*CreateTweetRequest)(nil),           // 0: v1.CreateTweetRequest
+	(*NewPoll)(nil),                      // 1: v1.NewPoll
+	(*CreateTweetResponse)(nil),          // 2: v1.CreateTweetResponse
+	(*UpdateTweetRequest)(nil),           // 3: v1.UpdateTweetRequest
+	(*UpdateTweetResponse)(nil),          // 4: v1.UpdateTweetResponse
+	(*GetTweetRequest)(nil),              // 5: v1.GetTweetRequest
+	(*GetTweetResponse)(nil),             // 6: v1.GetTweetResponse
+	(*DeleteTweetRequest)(nil),           // 7: v1.DeleteTweetRequest
+	(*DeleteTweetResponse)(nil),          // 8: v1.DeleteTweetResponse
+	(*ListTweetRequest)(nil),             // 9: v1.ListTweetRequest
+	(*ListTweetResponse)(nil),            // 10: v1.ListTweetResponse
+	(*RetweetRequest)(nil),               // 11: v1.RetweetRequest
+	(*RetweetResponse)(nil),              // 12: v1.RetweetResponse
+	(*UndoRetweetRequest)(nil),           // 13: v1.UndoRetweetRequest
+	(*UndoRetweetResponse)(nil),          // 14: v1.UndoRetweetResponse
+	(*ListHashtagTweetsRequest)(nil),     // 15: v1.ListHashtagTweetsRequest
+	(*LikeRequest)(nil),                  // 16: v1.LikeRequest
+	(*LikeResponse)(nil),                 // 17: v1.LikeResponse
+	(*UnlikeRequest)(nil),                // 18: v1.UnlikeRequest
+	(*UnlikeResponse)(nil),               // 19: v1.UnlikeResponse
+	(*ListLikersRequest)(nil),            // 20: v1.ListLikersRequest
+	(*ListLikersResponse)(nil),           // 21: v1.ListLikersResponse
+	(*GetConversationRequest)(nil),       // 22: v1.GetConversationRequest
+	(*GetConversationResponse)(nil),      // 23: v1.GetConversationResponse
+	(*SearchTweetsRequest)(nil),          // 24: v1.SearchTweetsRequest
+	(*SearchTweetsResponse)(nil),         // 25: v1.SearchTweetsResponse
+	(*StreamTweetsRequest)(nil),          // 26: v1.StreamTweetsRequest
+	(*StreamTweetsResponse)(nil),         // 27: v1.StreamTweetsResponse
+	(*ListRevisionsRequest)(nil),         // 28: v1.ListRevisionsRequest
+	(*ListRevisionsResponse)(nil),        // 29: v1.ListRevisionsResponse
+	(*CreateScheduledTweetRequest)(nil),  // 30: v1.CreateScheduledTweetRequest
+	(*CreateScheduledTweetResponse)(nil), // 31: v1.CreateScheduledTweetResponse
+	(*ListScheduledTweetsRequest)(nil),   // 32: v1.ListScheduledTweetsRequest
+	(*ListScheduledTweetsResponse)(nil),  // 33: v1.ListScheduledTweetsResponse
+	(*UpdateScheduledTweetRequest)(nil),  // 34: v1.UpdateScheduledTweetRequest
+	(*UpdateScheduledTweetResponse)(nil), // 35: v1.UpdateScheduledTweetResponse
+	(*CancelScheduledTweetRequest)(nil),  // 36: v1.CancelScheduledTweetRequest
+	(*CancelScheduledTweetResponse)(nil), // 37: v1.CancelScheduledTweetResponse
+	(*UploadMediaRequest)(nil),           // 38: v1.UploadMediaRequest
+	(*UploadMediaResponse)(nil),          // 39: v1.UploadMediaResponse
+	(*VoteRequest)(nil),                  // 40: v1.VoteRequest
+	(*VoteResponse)(nil),                 // 41: v1.VoteResponse
+	(*BookmarkRequest)(nil),              // 42: v1.BookmarkRequest
+	(*BookmarkResponse)(nil),             // 43: v1.BookmarkResponse
+	(*UnbookmarkRequest)(nil),            // 44: v1.UnbookmarkRequest
+	(*UnbookmarkResponse)(nil),           // 45: v1.UnbookmarkResponse
+	(*ListBookmarksRequest)(nil),         // 46: v1.ListBookmarksRequest
+	(*ListBookmarksResponse)(nil),        // 47: v1.ListBookmarksResponse
+	(*CreateBookmarkFolderRequest)(nil),  // 48: v1.CreateBookmarkFolderRequest
+	(*CreateBookmarkFolderResponse)(nil), // 49: v1.CreateBookmarkFolderResponse
+	(*ListBookmarkFoldersRequest)(nil),   // 50: v1.ListBookmarkFoldersRequest
+	(*ListBookmarkFoldersResponse)(nil),  // 51: v1.ListBookmarkFoldersResponse
+	(*DeleteBookmarkFolderRequest)(nil),  // 52: v1.DeleteBookmarkFolderRequest
+	(*DeleteBookmarkFolderResponse)(nil), // 53: v1.DeleteBookmarkFolderResponse
+	(*PinTweetRequest)(nil),              // 54: v1.PinTweetRequest
+	(*PinTweetResponse)(nil),             // 55: v1.PinTweetResponse
+	(*UnpinTweetRequest)(nil),            // 56: v1.UnpinTweetRequest
+	(*UnpinTweetResponse)(nil),           // 57: v1.UnpinTweetResponse
+	(Visibility)(0),                      // 58: v1.Visibility
+	(ReplyPolicy)(0),                     // 59: v1.ReplyPolicy
+	(*timestamp.Timestamp)(nil),          // 60: google.protobuf.Timestamp
+	(*Tweet)(nil),                        // 61: v1.Tweet
+	(*User)(nil),                         // 62: v1.User
+	(*TweetRevision)(nil),                // 63: v1.TweetRevision
+	(*ScheduledTweet)(nil),               // 64: v1.ScheduledTweet
+	(*Media)(nil),                        // 65: v1.Media
+	(*Poll)(nil),                         // 66: v1.Poll
+	(*BookmarkFolder)(nil),               // 67: v1.BookmarkFolder
+}
+var file_tweet_service_proto_depIdxs = []int32{
+	1,  // 0: v1.CreateTweetRequest.poll:type_name -> v1.NewPoll
+	58, // 1: v1.CreateTweetRequest.visibility:type_name -> v1.Visibility
+	59, // 2: v1.CreateTweetRequest.reply_policy:type_name -> v1.ReplyPolicy
+	60, // 3: v1.NewPoll.closes_at:type_name -> google.protobuf.Timestamp
+	61, // 4: v1.GetTweetResponse.tweet:type_name -> v1.Tweet
+	61, // 5: v1.ListTweetResponse.tweet:type_name -> v1.Tweet
+	62, // 6: v1.ListLikersResponse.user:type_name -> v1.User
+	61, // 7: v1.GetConversationResponse.tweet:type_name -> v1.Tweet
+	61, // 8: v1.SearchTweetsResponse.tweet:type_name -> v1.Tweet
+	61, // 9: v1.StreamTweetsResponse.tweet:type_name -> v1.Tweet
+	63, // 10: v1.ListRevisionsResponse.revision:type_name -> v1.TweetRevision
+	60, // 11: v1.CreateScheduledTweetRequest.publish_at:type_name -> google.protobuf.Timestamp
+	64, // 12: v1.CreateScheduledTweetResponse.scheduled_tweet:type_name -> v1.ScheduledTweet
+	64, // 13: v1.ListScheduledTweetsResponse.scheduled_tweet:type_name -> v1.ScheduledTweet
+	60, // 14: v1.UpdateScheduledTweetRequest.publish_at:type_name -> google.protobuf.Timestamp
+	64, // 15: v1.UpdateScheduledTweetResponse.scheduled_tweet:type_name -> v1.ScheduledTweet
+	65, // 16: v1.UploadMediaResponse.media:type_name -> v1.Media
+	66, // 17: v1.VoteResponse.poll:type_name -> v1.Poll
+	61, // 18: v1.ListBookmarksResponse.tweet:type_name -> v1.Tweet
+	67, // 19: v1.CreateBookmarkFolderResponse.folder:type_name -> v1.BookmarkFolder
+	67, // 20: v1.ListBookmarkFoldersResponse.folder:type_name -> v1.BookmarkFolder
+	0,  // 21: v1.tweetService.Create:input_type -> v1.CreateTweetRequest
+	3,  // 22: v1.tweetService.Update:input_type -> v1.UpdateTweetRequest
+	7,  // 23: v1.tweetService.Delete:input_type -> v1.DeleteTweetRequest
+	5,  // 24: v1.tweetService.Get:input_type -> v1.GetTweetRequest
+	9,  // 25: v1.tweetService.List:input_type -> v1.ListTweetRequest
+	22, // 26: v1.tweetService.GetConversation:input_type -> v1.GetConversationRequest
+	11, // 27: v1.tweetService.Retweet:input_type -> v1.RetweetRequest
+	13, // 28: v1.tweetService.UndoRetweet:input_type -> v1.UndoRetweetRequest
+	16, // 29: v1.tweetService.Like:input_type -> v1.LikeRequest
+	18, // 30: v1.tweetService.Unlike:input_type -> v1.UnlikeRequest
+	20, // 31: v1.tweetService.ListLikers:input_type -> v1.ListLikersRequest
+	15, // 32: v1.tweetService.ListHashtagTweets:input_type -> v1.ListHashtagTweetsRequest
+	24, // 33: v1.tweetService.SearchTweets:input_type -> v1.SearchTweetsRequest
+	26, // 34: v1.tweetService.StreamTweets:input_type -> v1.StreamTweetsRequest
+	28, // 35: v1.tweetService.ListRevisions:input_type -> v1.ListRevisionsRequest
+	30, // 36: v1.tweetService.CreateScheduledTweet:input_type -> v1.CreateScheduledTweetRequest
+	32, // 37: v1.tweetService.ListScheduledTweets:input_type -> v1.ListScheduledTweetsRequest
+	34, // 38: v1.tweetService.UpdateScheduledTweet:input_type -> v1.UpdateScheduledTweetRequest
+	36, // 39: v1.tweetService.CancelScheduledTweet:input_type -> v1.CancelScheduledTweetRequest
+	38, // 40: v1.tweetService.UploadMedia:input_type -> v1.UploadMediaRequest
+	40, // 41: v1.tweetService.Vote:input_type -> v1.VoteRequest
+	42, // 42: v1.tweetService.Bookmark:input_type -> v1.BookmarkRequest
+	44, // 43: v1.tweetService.Unbookmark:input_type -> v1.UnbookmarkRequest
+	46, // 44: v1.tweetService.ListBookmarks:input_type -> v1.ListBookmarksRequest
+	48, // 45: v1.tweetService.CreateBookmarkFolder:input_type -> v1.CreateBookmarkFolderRequest
+	50, // 46: v1.tweetService.ListBookmarkFolders:input_type -> v1.ListBookmarkFoldersRequest
+	52, // 47: v1.tweetService.DeleteBookmarkFolder:input_type -> v1.DeleteBookmarkFolderRequest
+	54, // 48: v1.tweetService.PinTweet:input_type -> v1.PinTweetRequest
+	56, // 49: v1.tweetService.UnpinTweet:input_type -> v1.UnpinTweetRequest
+	2,  // 50: v1.tweetService.Create:output_type -> v1.CreateTweetResponse
+	4,  // 51: v1.tweetService.Update:output_type -> v1.UpdateTweetResponse
+	8,  // 52: v1.tweetService.Delete:output_type -> v1.DeleteTweetResponse
+	6,  // 53: v1.tweetService.Get:output_type -> v1.GetTweetResponse
+	10, // 54: v1.tweetService.List:output_type -> v1.ListTweetResponse
+	23, // 55: v1.tweetService.GetConversation:output_type -> v1.GetConversationResponse
+	12, // 56: v1.tweetService.Retweet:output_type -> v1.RetweetResponse
+	14, // 57: v1.tweetService.UndoRetweet:output_type -> v1.UndoRetweetResponse
+	17, // 58: v1.tweetService.Like:output_type -> v1.LikeResponse
+	19, // 59: v1.tweetService.Unlike:output_type -> v1.UnlikeResponse
+	21, // 60: v1.tweetService.ListLikers:output_type -> v1.ListLikersResponse
+	10, // 61: v1.tweetService.ListHashtagTweets:output_type -> v1.ListTweetResponse
+	25, // 62: v1.tweetService.SearchTweets:output_type -> v1.SearchTweetsResponse
+	27, // 63: v1.tweetService.StreamTweets:output_type -> v1.StreamTweetsResponse
+	29, // 64: v1.tweetService.ListRevisions:output_type -> v1.ListRevisionsResponse
+	31, // 65: v1.tweetService.CreateScheduledTweet:output_type -> v1.CreateScheduledTweetResponse
+	33, // 66: v1.tweetService.ListScheduledTweets:output_type -> v1.ListScheduledTweetsResponse
+	35, // 67: v1.tweetService.UpdateScheduledTweet:output_type -> v1.UpdateScheduledTweetResponse
+	37, // 68: v1.tweetService.CancelScheduledTweet:output_type -> v1.CancelScheduledTweetResponse
+	39, // 69: v1.tweetService.UploadMedia:output_type -> v1.UploadMediaResponse
+	41, // 70: v1.tweetService.Vote:output_type -> v1.VoteResponse
+	43, // 71: v1.tweetService.Bookmark:output_type -> v1.BookmarkResponse
+	45, // 72: v1.tweetService.Unbookmark:output_type -> v1.UnbookmarkResponse
+	47, // 73: v1.tweetService.ListBookmarks:output_type -> v1.ListBookmarksResponse
+	49, // 74: v1.tweetService.CreateBookmarkFolder:output_type -> v1.CreateBookmarkFolderResponse
+	51, // 75: v1.tweetService.ListBookmarkFolders:output_type -> v1.ListBookmarkFoldersResponse
+	53, // 76: v1.tweetService.DeleteBookmarkFolder:output_type -> v1.DeleteBookmarkFolderResponse
+	55, // 77: v1.tweetService.PinTweet:output_type -> v1.PinTweetResponse
+	57, // 78: v1.tweetService.UnpinTweet:output_type -> v1.UnpinTweetResponse
+	50, // [50:79] is the sub-list for method output_type
+	21, // [21:50] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_tweet_service_proto_init() }
+func file_tweet_service_proto_init() {
+	if File_tweet_service_proto != nil {
+		return
+	}
+	file_tweet_message_proto_init()
+	file_user_message_proto_init()
+	file_media_message_proto_init()
+	file_poll_message_proto_init()
+	file_visibility_message_proto_init()
+	file_bookmark_message_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_tweet_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewPoll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoRetweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoRetweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHashtagTweetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLikersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLikersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchTweetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchTweetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTweetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTweetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScheduledTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScheduledTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledTweetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledTweetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateScheduledTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateScheduledTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadMediaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadMediaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookmarkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbookmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbookmarkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBookmarksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBookmarksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBookmarkFolderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBookmarkFolderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBookmarkFoldersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBookmarkFoldersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBookmarkFolderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBookmarkFolderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tweet_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tweet_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   58,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tweet_service_proto_goTypes,
+		DependencyIndexes: file_tweet_service_proto_depIdxs,
+		MessageInfos:      file_tweet_service_proto_msgTypes,
+	}.Build()
+	File_tweet_service_proto = out.File
+	file_tweet_service_proto_rawDesc = nil
+	file_tweet_service_proto_goTypes = nil
+	file_tweet_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// TweetServiceClient is the client API for TweetService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TweetServiceClient interface {
+	// create a tweet service
+	Create(ctx context.Context, in *CreateTweetRequest, opts ...grpc.CallOption) (*CreateTweetResponse, error)
+	// update a tweet service
+	Update(ctx context.Context, in *UpdateTweetRequest, opts ...grpc.CallOption) (*UpdateTweetResponse, error)
+	// delete a tweet service
+	Delete(ctx context.Context, in *DeleteTweetRequest, opts ...grpc.CallOption) (*DeleteTweetResponse, error)
+	// get a tweet service
+	Get(ctx context.Context, in *GetTweetRequest, opts ...grpc.CallOption) (*GetTweetResponse, error)
+	// list a tweet service
+	List(ctx context.Context, in *ListTweetRequest, opts ...grpc.CallOption) (TweetService_ListClient, error)
+	// get the conversation thread of a tweet
+	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (TweetService_GetConversationClient, error)
+	// retweet a tweet service
+	Retweet(ctx context.Context, in *RetweetRequest, opts ...grpc.CallOption) (*RetweetResponse, error)
+	// undo a retweet service
+	UndoRetweet(ctx context.Context, in *UndoRetweetRequest, opts ...grpc.CallOption) (*UndoRetweetResponse, error)
+	// like a tweet service
+	Like(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*LikeResponse, error)
+	// unlike a tweet service
+	Unlike(ctx context.Context, in *UnlikeRequest, opts ...grpc.CallOption) (*UnlikeResponse, error)
+	// list users who liked a tweet service
+	ListLikers(ctx context.Context, in *ListLikersRequest, opts ...grpc.CallOption) (TweetService_ListLikersClient, error)
+	// list tweets with a hashtag service
+	ListHashtagTweets(ctx context.Context, in *ListHashtagTweetsRequest, opts ...grpc.CallOption) (TweetService_ListHashtagTweetsClient, error)
+	// full text search tweets service
+	SearchTweets(ctx context.Context, in *SearchTweetsRequest, opts ...grpc.CallOption) (TweetService_SearchTweetsClient, error)
+	// stream new tweets matching rules service
+	StreamTweets(ctx context.Context, in *StreamTweetsRequest, opts ...grpc.CallOption) (TweetService_StreamTweetsClient, error)
+	// list edit history of a tweet service
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (TweetService_ListRevisionsClient, error)
+	// save a draft or schedule a tweet service
+	CreateScheduledTweet(ctx context.Context, in *CreateScheduledTweetRequest, opts ...grpc.CallOption) (*CreateScheduledTweetResponse, error)
+	// list drafts or scheduled tweets service
+	ListScheduledTweets(ctx context.Context, in *ListScheduledTweetsRequest, opts ...grpc.CallOption) (TweetService_ListScheduledTweetsClient, error)
+	// edit or reschedule a draft or scheduled tweet service
+	UpdateScheduledTweet(ctx context.Context, in *UpdateScheduledTweetRequest, opts ...grpc.CallOption) (*UpdateScheduledTweetResponse, error)
+	// delete a draft or cancel a scheduled tweet service
+	CancelScheduledTweet(ctx context.Context, in *CancelScheduledTweetRequest, opts ...grpc.CallOption) (*CancelScheduledTweetResponse, error)
+	// upload an image or a video service
+	UploadMedia(ctx context.Context, opts ...grpc.CallOption) (TweetService_UploadMediaClient, error)
+	// vote in a poll service
+	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	// bookmark a tweet service
+	Bookmark(ctx context.Context, in *BookmarkRequest, opts ...grpc.CallOption) (*BookmarkResponse, error)
+	// remove a bookmark service
+	Unbookmark(ctx context.Context, in *UnbookmarkRequest, opts ...grpc.CallOption) (*UnbookmarkResponse, error)
+	// list bookmarked tweets service
+	ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (TweetService_ListBookmarksClient, error)
+	// create a bookmark folder service
+	CreateBookmarkFolder(ctx context.Context, in *CreateBookmarkFolderRequest, opts ...grpc.CallOption) (*CreateBookmarkFolderResponse, error)
+	// list bookmark folders service
+	ListBookmarkFolders(ctx context.Context, in *ListBookmarkFoldersRequest, opts ...grpc.CallOption) (TweetService_ListBookmarkFoldersClient, error)
+	// delete a bookmark folder service
+	DeleteBookmarkFolder(ctx context.Context, in *DeleteBookmarkFolderRequest, opts ...grpc.CallOption) (*DeleteBookmarkFolderResponse, error)
+	// pin a tweet on the user profile service
+	PinTweet(ctx context.Context, in *PinTweetRequest, opts ...grpc.CallOption) (*PinTweetResponse, error)
+	// unpin the user pinned tweet service
+	UnpinTweet(ctx context.Context, in *UnpinTweetRequest, opts ...grpc.CallOption) (*UnpinTweetResponse, error)
+}
+
+type tweetServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTweetServiceClient(cc grpc.ClientConnInterface) TweetServiceClient {
+	return &tweetServiceClient{cc}
+}
+
+func (c *tweetServiceClient) Create(ctx context.Context, in *CreateTweetRequest, opts ...grpc.CallOption) (*CreateTweetResponse, error) {
+	out := new(CreateTweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) Update(ctx context.Context, in *UpdateTweetRequest, opts ...grpc.CallOption) (*UpdateTweetResponse, error) {
+	out := new(UpdateTweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) Delete(ctx context.Context, in *DeleteTweetRequest, opts ...grpc.CallOption) (*DeleteTweetResponse, error) {
+	out := new(DeleteTweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) Get(ctx context.Context, in *GetTweetRequest, opts ...grpc.CallOption) (*GetTweetResponse, error) {
+	out := new(GetTweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) List(ctx context.Context, in *ListTweetRequest, opts ...grpc.CallOption) (TweetService_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[0], "/v1.tweetService/List", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TweetService_ListClient interface {
+	Recv() (*ListTweetResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceListClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceListClient) Recv() (*ListTweetResponse, error) {
+	m := new(ListTweetResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (TweetService_GetConversationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[1], "/v1.tweetService/GetConversation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceGetConversationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TweetService_GetConversationClient interface {
+	Recv() (*GetConversationResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceGetConversationClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceGetConversationClient) Recv() (*GetConversationResponse, error) {
+	m := new(GetConversationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) Retweet(ctx context.Context, in *RetweetRequest, opts ...grpc.CallOption) (*RetweetResponse, error) {
+	out := new(RetweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/Retweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) UndoRetweet(ctx context.Context, in *UndoRetweetRequest, opts ...grpc.CallOption) (*UndoRetweetResponse, error) {
+	out := new(UndoRetweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/UndoRetweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) Like(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*LikeResponse, error) {
+	out := new(LikeResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/Like", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) Unlike(ctx context.Context, in *UnlikeRequest, opts ...grpc.CallOption) (*UnlikeResponse, error) {
+	out := new(UnlikeResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/Unlike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) ListLikers(ctx context.Context, in *ListLikersRequest, opts ...grpc.CallOption) (TweetService_ListLikersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[2], "/v1.tweetService/ListLikers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceListLikersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TweetService_ListLikersClient interface {
+	Recv() (*ListLikersResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceListLikersClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceListLikersClient) Recv() (*ListLikersResponse, error) {
+	m := new(ListLikersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) ListHashtagTweets(ctx context.Context, in *ListHashtagTweetsRequest, opts ...grpc.CallOption) (TweetService_ListHashtagTweetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[3], "/v1.tweetService/ListHashtagTweets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceListHashtagTweetsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TweetService_ListHashtagTweetsClient interface {
+	Recv() (*ListTweetResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceListHashtagTweetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceListHashtagTweetsClient) Recv() (*ListTweetResponse, error) {
+	m := new(ListTweetResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) SearchTweets(ctx context.Context, in *SearchTweetsRequest, opts ...grpc.CallOption) (TweetService_SearchTweetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[4], "/v1.tweetService/SearchTweets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceSearchTweetsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TweetService_SearchTweetsClient interface {
+	Recv() (*SearchTweetsResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceSearchTweetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceSearchTweetsClient) Recv() (*SearchTweetsResponse, error) {
+	m := new(SearchTweetsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) StreamTweets(ctx context.Context, in *StreamTweetsRequest, opts ...grpc.CallOption) (TweetService_StreamTweetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[5], "/v1.tweetService/StreamTweets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceStreamTweetsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TweetService_StreamTweetsClient interface {
+	Recv() (*StreamTweetsResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceStreamTweetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceStreamTweetsClient) Recv() (*StreamTweetsResponse, error) {
+	m := new(StreamTweetsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (TweetService_ListRevisionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[6], "/v1.tweetService/ListRevisions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceListRevisionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TweetService_ListRevisionsClient interface {
+	Recv() (*ListRevisionsResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceListRevisionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceListRevisionsClient) Recv() (*ListRevisionsResponse, error) {
+	m := new(ListRevisionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) CreateScheduledTweet(ctx context.Context, in *CreateScheduledTweetRequest, opts ...grpc.CallOption) (*CreateScheduledTweetResponse, error) {
+	out := new(CreateScheduledTweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/CreateScheduledTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) ListScheduledTweets(ctx context.Context, in *ListScheduledTweetsRequest, opts ...grpc.CallOption) (TweetService_ListScheduledTweetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[7], "/v1.tweetService/ListScheduledTweets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceListScheduledTweetsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TweetService_ListScheduledTweetsClient interface {
+	Recv() (*ListScheduledTweetsResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceListScheduledTweetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceListScheduledTweetsClient) Recv() (*ListScheduledTweetsResponse, error) {
+	m := new(ListScheduledTweetsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) UpdateScheduledTweet(ctx context.Context, in *UpdateScheduledTweetRequest, opts ...grpc.CallOption) (*UpdateScheduledTweetResponse, error) {
+	out := new(UpdateScheduledTweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/UpdateScheduledTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) CancelScheduledTweet(ctx context.Context, in *CancelScheduledTweetRequest, opts ...grpc.CallOption) (*CancelScheduledTweetResponse, error) {
+	out := new(CancelScheduledTweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/CancelScheduledTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) UploadMedia(ctx context.Context, opts ...grpc.CallOption) (TweetService_UploadMediaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[8], "/v1.tweetService/UploadMedia", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceUploadMediaClient{stream}
+	return x, nil
+}
+
+type TweetService_UploadMediaClient interface {
+	Send(*UploadMediaRequest) error
+	CloseAndRecv() (*UploadMediaResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceUploadMediaClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceUploadMediaClient) Send(m *UploadMediaRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *tweetServiceUploadMediaClient) CloseAndRecv() (*UploadMediaResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadMediaResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) Bookmark(ctx context.Context, in *BookmarkRequest, opts ...grpc.CallOption) (*BookmarkResponse, error) {
+	out := new(BookmarkResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/Bookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) Unbookmark(ctx context.Context, in *UnbookmarkRequest, opts ...grpc.CallOption) (*UnbookmarkResponse, error) {
+	out := new(UnbookmarkResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/Unbookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (TweetService_ListBookmarksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[9], "/v1.tweetService/ListBookmarks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceListBookmarksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TweetService_ListBookmarksClient interface {
+	Recv() (*ListBookmarksResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceListBookmarksClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceListBookmarksClient) Recv() (*ListBookmarksResponse, error) {
+	m := new(ListBookmarksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) CreateBookmarkFolder(ctx context.Context, in *CreateBookmarkFolderRequest, opts ...grpc.CallOption) (*CreateBookmarkFolderResponse, error) {
+	out := new(CreateBookmarkFolderResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/CreateBookmarkFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) ListBookmarkFolders(ctx context.Context, in *ListBookmarkFoldersRequest, opts ...grpc.CallOption) (TweetService_ListBookmarkFoldersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[10], "/v1.tweetService/ListBookmarkFolders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tweetServiceListBookmarkFoldersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TweetService_ListBookmarkFoldersClient interface {
+	Recv() (*ListBookmarkFoldersResponse, error)
+	grpc.ClientStream
+}
+
+type tweetServiceListBookmarkFoldersClient struct {
+	grpc.ClientStream
+}
+
+func (x *tweetServiceListBookmarkFoldersClient) Recv() (*ListBookmarkFoldersResponse, error) {
+	m := new(ListBookmarkFoldersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tweetServiceClient) DeleteBookmarkFolder(ctx context.Context, in *DeleteBookmarkFolderRequest, opts ...grpc.CallOption) (*DeleteBookmarkFolderResponse, error) {
+	out := new(DeleteBookmarkFolderResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/DeleteBookmarkFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) PinTweet(ctx context.Context, in *PinTweetRequest, opts ...grpc.CallOption) (*PinTweetResponse, error) {
+	out := new(PinTweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/PinTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) UnpinTweet(ctx context.Context, in *UnpinTweetRequest, opts ...grpc.CallOption) (*UnpinTweetResponse, error) {
+	out := new(UnpinTweetResponse)
+	err := c.cc.Invoke(ctx, "/v1.tweetService/UnpinTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TweetServiceServer is the server API for TweetService service.
+type TweetServiceServer interface {
+	// create a tweet service
+	Create(context.Context, *CreateTweetRequest) (*CreateTweetResponse, error)
+	// update a tweet service
+	Update(context.Context, *UpdateTweetRequest) (*UpdateTweetResponse, error)
+	// delete a tweet service
+	Delete(context.Context, *DeleteTweetRequest) (*DeleteTweetResponse, error)
+	// get a tweet service
+	Get(context.Context, *GetTweetRequest) (*GetTweetResponse, error)
+	// list a tweet service
+	List(*ListTweetRequest, TweetService_ListServer) error
+	// get the conversation thread of a tweet
+	GetConversation(*GetConversationRequest, TweetService_GetConversationServer) error
+	// retweet a tweet service
+	Retweet(context.Context, *RetweetRequest) (*RetweetResponse, error)
+	// undo a retweet service
+	UndoRetweet(context.Context, *UndoRetweetRequest) (*UndoRetweetResponse, error)
+	// like a tweet service
+	Like(context.Context, *LikeRequest) (*LikeResponse, error)
+	// unlike a tweet service
+	Unlike(context.Context, *UnlikeRequest) (*UnlikeResponse, error)
+	// list users who liked a tweet service
+	ListLikers(*ListLikersRequest, TweetService_ListLikersServer) error
+	// list tweets with a hashtag service
+	ListHashtagTweets(*ListHashtagTweetsRequest, TweetService_ListHashtagTweetsServer) error
+	// full text search tweets service
+	SearchTweets(*SearchTweetsRequest, TweetService_SearchTweetsServer) error
+	// stream new tweets matching rules service
+	StreamTweets(*StreamTweetsRequest, TweetService_StreamTweetsServer) error
+	// list edit history of a tweet service
+	ListRevisions(*ListRevisionsRequest, TweetService_ListRevisionsServer) error
+	// save a draft or schedule a tweet service
+	CreateScheduledTweet(context.Context, *CreateScheduledTweetRequest) (*CreateScheduledTweetResponse, error)
+	// list drafts or scheduled tweets service
+	ListScheduledTweets(*ListScheduledTweetsRequest, TweetService_ListScheduledTweetsServer) error
+	// edit or reschedule a draft or scheduled tweet service
+	UpdateScheduledTweet(context.Context, *UpdateScheduledTweetRequest) (*UpdateScheduledTweetResponse, error)
+	// delete a draft or cancel a scheduled tweet service
+	CancelScheduledTweet(context.Context, *CancelScheduledTweetRequest) (*CancelScheduledTweetResponse, error)
+	// upload an image or a video service
+	UploadMedia(TweetService_UploadMediaServer) error
+	// vote in a poll service
+	Vote(context.Context, *VoteRequest) (*VoteResponse, error)
+	// bookmark a tweet service
+	Bookmark(context.Context, *BookmarkRequest) (*BookmarkResponse, error)
+	// remove a bookmark service
+	Unbookmark(context.Context, *UnbookmarkRequest) (*UnbookmarkResponse, error)
+	// list bookmarked tweets service
+	ListBookmarks(*ListBookmarksRequest, TweetService_ListBookmarksServer) error
+	// create a bookmark folder service
+	CreateBookmarkFolder(context.Context, *CreateBookmarkFolderRequest) (*CreateBookmarkFolderResponse, error)
+	// list bookmark folders service
+	ListBookmarkFolders(*ListBookmarkFoldersRequest, TweetService_ListBookmarkFoldersServer) error
+	// delete a bookmark folder service
+	DeleteBookmarkFolder(context.Context, *DeleteBookmarkFolderRequest) (*DeleteBookmarkFolderResponse, error)
+	// pin a tweet on the user profile service
+	PinTweet(context.Context, *PinTweetRequest) (*PinTweetResponse, error)
+	// unpin the user pinned tweet service
+	UnpinTweet(context.Context, *UnpinTweetRequest) (*UnpinTweetResponse, error)
+}
+
+// UnimplementedTweetServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTweetServiceServer struct {
+}
+
+func (*UnimplementedTweetServiceServer) Create(context.Context, *CreateTweetRequest) (*CreateTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedTweetServiceServer) Update(context.Context, *UpdateTweetRequest) (*UpdateTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedTweetServiceServer) Delete(context.Context, *DeleteTweetRequest) (*DeleteTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedTweetServiceServer) Get(context.Context, *GetTweetRequest) (*GetTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedTweetServiceServer) List(*ListTweetRequest, TweetService_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedTweetServiceServer) GetConversation(*GetConversationRequest, TweetService_GetConversationServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetConversation not implemented")
+}
+func (*UnimplementedTweetServiceServer) Retweet(context.Context, *RetweetRequest) (*RetweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retweet not implemented")
+}
+func (*UnimplementedTweetServiceServer) UndoRetweet(context.Context, *UndoRetweetRequest) (*UndoRetweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoRetweet not implemented")
+}
+func (*UnimplementedTweetServiceServer) Like(context.Context, *LikeRequest) (*LikeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Like not implemented")
+}
+func (*UnimplementedTweetServiceServer) Unlike(context.Context, *UnlikeRequest) (*UnlikeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlike not implemented")
+}
+func (*UnimplementedTweetServiceServer) ListLikers(*ListLikersRequest, TweetService_ListLikersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListLikers not implemented")
+}
+func (*UnimplementedTweetServiceServer) ListHashtagTweets(*ListHashtagTweetsRequest, TweetService_ListHashtagTweetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListHashtagTweets not implemented")
+}
+func (*UnimplementedTweetServiceServer) SearchTweets(*SearchTweetsRequest, TweetService_SearchTweetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SearchTweets not implemented")
+}
+func (*UnimplementedTweetServiceServer) StreamTweets(*StreamTweetsRequest, TweetService_StreamTweetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTweets not implemented")
+}
+func (*UnimplementedTweetServiceServer) ListRevisions(*ListRevisionsRequest, TweetService_ListRevisionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (*UnimplementedTweetServiceServer) CreateScheduledTweet(context.Context, *CreateScheduledTweetRequest) (*CreateScheduledTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledTweet not implemented")
+}
+func (*UnimplementedTweetServiceServer) ListScheduledTweets(*ListScheduledTweetsRequest, TweetService_ListScheduledTweetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListScheduledTweets not implemented")
+}
+func (*UnimplementedTweetServiceServer) UpdateScheduledTweet(context.Context, *UpdateScheduledTweetRequest) (*UpdateScheduledTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledTweet not implemented")
+}
+func (*UnimplementedTweetServiceServer) CancelScheduledTweet(context.Context, *CancelScheduledTweetRequest) (*CancelScheduledTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTweet not implemented")
+}
+func (*UnimplementedTweetServiceServer) UploadMedia(TweetService_UploadMediaServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMedia not implemented")
+}
+func (*UnimplementedTweetServiceServer) Vote(context.Context, *VoteRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedTweetServiceServer) Bookmark(context.Context, *BookmarkRequest) (*BookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bookmark not implemented")
+}
+func (*UnimplementedTweetServiceServer) Unbookmark(context.Context, *UnbookmarkRequest) (*UnbookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unbookmark not implemented")
+}
+func (*UnimplementedTweetServiceServer) ListBookmarks(*ListBookmarksRequest, TweetService_ListBookmarksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+}
+func (*UnimplementedTweetServiceServer) CreateBookmarkFolder(context.Context, *CreateBookmarkFolderRequest) (*CreateBookmarkFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBookmarkFolder not implemented")
+}
+func (*UnimplementedTweetServiceServer) ListBookmarkFolders(*ListBookmarkFoldersRequest, TweetService_ListBookmarkFoldersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBookmarkFolders not implemented")
+}
+func (*UnimplementedTweetServiceServer) DeleteBookmarkFolder(context.Context, *DeleteBookmarkFolderRequest) (*DeleteBookmarkFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBookmarkFolder not implemented")
+}
+func (*UnimplementedTweetServiceServer) PinTweet(context.Context, *PinTweetRequest) (*PinTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinTweet not implemented")
+}
+func (*UnimplementedTweetServiceServer) UnpinTweet(context.Context, *UnpinTweetRequest) (*UnpinTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinTweet not implemented")
+}
+
+func RegisterTweetServiceServer(s *grpc.Server, srv TweetServiceServer) {
+	s.RegisterService(&_TweetService_serviceDesc, srv)
+}
+
+func _TweetService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).Create(ctx, req.(*CreateTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).Update(ctx, req.(*UpdateTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).Delete(ctx, req.(*DeleteTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).Get(ctx, req.(*GetTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTweetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TweetServiceServer).List(m, &tweetServiceListServer{stream})
+}
+
+type TweetService_ListServer interface {
+	Send(*ListTweetResponse) error
+	grpc.ServerStream
+}
+
+type tweetServiceListServer struct {
+	grpc.ServerStream
+}
+
+func (x *tweetServiceListServer) Send(m *ListTweetResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TweetService_GetConversation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConversationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TweetServiceServer).GetConversation(m, &tweetServiceGetConversationServer{stream})
+}
+
+type TweetService_GetConversationServer interface {
+	Send(*GetConversationResponse) error
+	grpc.ServerStream
+}
+
+type tweetServiceGetConversationServer struct {
+	grpc.ServerStream
+}
+
+func (x *tweetServiceGetConversationServer) Send(m *GetConversationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TweetService_Retweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).Retweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/Retweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).Retweet(ctx, req.(*RetweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_UndoRetweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoRetweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).UndoRetweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/UndoRetweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).UndoRetweet(ctx, req.(*UndoRetweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_Like_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).Like(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/Like",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).Like(ctx, req.(*LikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_Unlike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).Unlike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/Unlike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).Unlike(ctx, req.(*UnlikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_ListLikers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListLikersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TweetServiceServer).ListLikers(m, &tweetServiceListLikersServer{stream})
+}
+
+type TweetService_ListLikersServer interface {
+	Send(*ListLikersResponse) error
+	grpc.ServerStream
+}
+
+type tweetServiceListLikersServer struct {
+	grpc.ServerStream
+}
+
+func (x *tweetServiceListLikersServer) Send(m *ListLikersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TweetService_ListHashtagTweets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListHashtagTweetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TweetServiceServer).ListHashtagTweets(m, &tweetServiceListHashtagTweetsServer{stream})
+}
+
+type TweetService_ListHashtagTweetsServer interface {
+	Send(*ListTweetResponse) error
+	grpc.ServerStream
+}
+
+type tweetServiceListHashtagTweetsServer struct {
+	grpc.ServerStream
+}
+
+func (x *tweetServiceListHashtagTweetsServer) Send(m *ListTweetResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TweetService_SearchTweets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchTweetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TweetServiceServer).SearchTweets(m, &tweetServiceSearchTweetsServer{stream})
+}
+
+type TweetService_SearchTweetsServer interface {
+	Send(*SearchTweetsResponse) error
+	grpc.ServerStream
+}
+
+type tweetServiceSearchTweetsServer struct {
+	grpc.ServerStream
+}
+
+func (x *tweetServiceSearchTweetsServer) Send(m *SearchTweetsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TweetService_StreamTweets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTweetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TweetServiceServer).StreamTweets(m, &tweetServiceStreamTweetsServer{stream})
+}
+
+type TweetService_StreamTweetsServer interface {
+	Send(*StreamTweetsResponse) error
+	grpc.ServerStream
+}
+
+type tweetServiceStreamTweetsServer struct {
+	grpc.ServerStream
+}
+
+func (x *tweetServiceStreamTweetsServer) Send(m *StreamTweetsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TweetService_ListRevisions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRevisionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TweetServiceServer).ListRevisions(m, &tweetServiceListRevisionsServer{stream})
+}
+
+type TweetService_ListRevisionsServer interface {
+	Send(*ListRevisionsResponse) error
+	grpc.ServerStream
+}
+
+type tweetServiceListRevisionsServer struct {
+	grpc.ServerStream
 }
 
-func NewTweetServiceClient(cc grpc.ClientConnInterface) TweetServiceClient {
-	return &tweetServiceClient{cc}
+func (x *tweetServiceListRevisionsServer) Send(m *ListRevisionsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *tweetServiceClient) Create(ctx context.Context, in *CreateTweetRequest, opts ...grpc.CallOption) (*CreateTweetResponse, error) {
-	out := new(CreateTweetResponse)
-	err := c.cc.Invoke(ctx, "/v1.tweetService/Create", in, out, opts...)
-	if err != nil {
+func _TweetService_CreateScheduledTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledTweetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TweetServiceServer).CreateScheduledTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/CreateScheduledTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).CreateScheduledTweet(ctx, req.(*CreateScheduledTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tweetServiceClient) Update(ctx context.Context, in *UpdateTweetRequest, opts ...grpc.CallOption) (*UpdateTweetResponse, error) {
-	out := new(UpdateTweetResponse)
-	err := c.cc.Invoke(ctx, "/v1.tweetService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _TweetService_ListScheduledTweets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListScheduledTweetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(TweetServiceServer).ListScheduledTweets(m, &tweetServiceListScheduledTweetsServer{stream})
 }
 
-func (c *tweetServiceClient) Delete(ctx context.Context, in *DeleteTweetRequest, opts ...grpc.CallOption) (*DeleteTweetResponse, error) {
-	out := new(DeleteTweetResponse)
-	err := c.cc.Invoke(ctx, "/v1.tweetService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TweetService_ListScheduledTweetsServer interface {
+	Send(*ListScheduledTweetsResponse) error
+	grpc.ServerStream
 }
 
-func (c *tweetServiceClient) Get(ctx context.Context, in *GetTweetRequest, opts ...grpc.CallOption) (*GetTweetResponse, error) {
-	out := new(GetTweetResponse)
-	err := c.cc.Invoke(ctx, "/v1.tweetService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type tweetServiceListScheduledTweetsServer struct {
+	grpc.ServerStream
 }
 
-func (c *tweetServiceClient) List(ctx context.Context, in *ListTweetRequest, opts ...grpc.CallOption) (TweetService_ListClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_TweetService_serviceDesc.Streams[0], "/v1.tweetService/List", opts...)
-	if err != nil {
+func (x *tweetServiceListScheduledTweetsServer) Send(m *ListScheduledTweetsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TweetService_UpdateScheduledTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledTweetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &tweetServiceListClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(TweetServiceServer).UpdateScheduledTweet(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/UpdateScheduledTweet",
 	}
-	return x, nil
-}
-
-type TweetService_ListClient interface {
-	Recv() (*ListTweetResponse, error)
-	grpc.ClientStream
-}
-
-type tweetServiceListClient struct {
-	grpc.ClientStream
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).UpdateScheduledTweet(ctx, req.(*UpdateScheduledTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *tweetServiceListClient) Recv() (*ListTweetResponse, error) {
-	m := new(ListTweetResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _TweetService_CancelScheduledTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTweetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(TweetServiceServer).CancelScheduledTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/CancelScheduledTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).CancelScheduledTweet(ctx, req.(*CancelScheduledTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// TweetServiceServer is the server API for TweetService service.
-type TweetServiceServer interface {
-	// create a tweet service
-	Create(context.Context, *CreateTweetRequest) (*CreateTweetResponse, error)
-	// update a tweet service
-	Update(context.Context, *UpdateTweetRequest) (*UpdateTweetResponse, error)
-	// delete a tweet service
-	Delete(context.Context, *DeleteTweetRequest) (*DeleteTweetResponse, error)
-	// get a tweet service
-	Get(context.Context, *GetTweetRequest) (*GetTweetResponse, error)
-	// list a tweet service
-	List(*ListTweetRequest, TweetService_ListServer) error
+func _TweetService_UploadMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TweetServiceServer).UploadMedia(&tweetServiceUploadMediaServer{stream})
 }
 
-// UnimplementedTweetServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedTweetServiceServer struct {
+type TweetService_UploadMediaServer interface {
+	SendAndClose(*UploadMediaResponse) error
+	Recv() (*UploadMediaRequest, error)
+	grpc.ServerStream
 }
 
-func (*UnimplementedTweetServiceServer) Create(context.Context, *CreateTweetRequest) (*CreateTweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
-}
-func (*UnimplementedTweetServiceServer) Update(context.Context, *UpdateTweetRequest) (*UpdateTweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
-}
-func (*UnimplementedTweetServiceServer) Delete(context.Context, *DeleteTweetRequest) (*DeleteTweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
-}
-func (*UnimplementedTweetServiceServer) Get(context.Context, *GetTweetRequest) (*GetTweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+type tweetServiceUploadMediaServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedTweetServiceServer) List(*ListTweetRequest, TweetService_ListServer) error {
-	return status.Errorf(codes.Unimplemented, "method List not implemented")
+
+func (x *tweetServiceUploadMediaServer) SendAndClose(m *UploadMediaResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func RegisterTweetServiceServer(s *grpc.Server, srv TweetServiceServer) {
-	s.RegisterService(&_TweetService_serviceDesc, srv)
+func (x *tweetServiceUploadMediaServer) Recv() (*UploadMediaRequest, error) {
+	m := new(UploadMediaRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func _TweetService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTweetRequest)
+func _TweetService_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TweetServiceServer).Create(ctx, in)
+		return srv.(TweetServiceServer).Vote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.tweetService/Create",
+		FullMethod: "/v1.tweetService/Vote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TweetServiceServer).Create(ctx, req.(*CreateTweetRequest))
+		return srv.(TweetServiceServer).Vote(ctx, req.(*VoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TweetService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTweetRequest)
+func _TweetService_Bookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookmarkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TweetServiceServer).Update(ctx, in)
+		return srv.(TweetServiceServer).Bookmark(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.tweetService/Update",
+		FullMethod: "/v1.tweetService/Bookmark",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TweetServiceServer).Update(ctx, req.(*UpdateTweetRequest))
+		return srv.(TweetServiceServer).Bookmark(ctx, req.(*BookmarkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TweetService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTweetRequest)
+func _TweetService_Unbookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbookmarkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TweetServiceServer).Delete(ctx, in)
+		return srv.(TweetServiceServer).Unbookmark(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.tweetService/Delete",
+		FullMethod: "/v1.tweetService/Unbookmark",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TweetServiceServer).Delete(ctx, req.(*DeleteTweetRequest))
+		return srv.(TweetServiceServer).Unbookmark(ctx, req.(*UnbookmarkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TweetService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTweetRequest)
+func _TweetService_ListBookmarks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBookmarksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TweetServiceServer).ListBookmarks(m, &tweetServiceListBookmarksServer{stream})
+}
+
+type TweetService_ListBookmarksServer interface {
+	Send(*ListBookmarksResponse) error
+	grpc.ServerStream
+}
+
+type tweetServiceListBookmarksServer struct {
+	grpc.ServerStream
+}
+
+func (x *tweetServiceListBookmarksServer) Send(m *ListBookmarksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TweetService_CreateBookmarkFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookmarkFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TweetServiceServer).Get(ctx, in)
+		return srv.(TweetServiceServer).CreateBookmarkFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.tweetService/Get",
+		FullMethod: "/v1.tweetService/CreateBookmarkFolder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TweetServiceServer).Get(ctx, req.(*GetTweetRequest))
+		return srv.(TweetServiceServer).CreateBookmarkFolder(ctx, req.(*CreateBookmarkFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TweetService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListTweetRequest)
+func _TweetService_ListBookmarkFolders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBookmarkFoldersRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(TweetServiceServer).List(m, &tweetServiceListServer{stream})
+	return srv.(TweetServiceServer).ListBookmarkFolders(m, &tweetServiceListBookmarkFoldersServer{stream})
 }
 
-type TweetService_ListServer interface {
-	Send(*ListTweetResponse) error
+type TweetService_ListBookmarkFoldersServer interface {
+	Send(*ListBookmarkFoldersResponse) error
 	grpc.ServerStream
 }
 
-type tweetServiceListServer struct {
+type tweetServiceListBookmarkFoldersServer struct {
 	grpc.ServerStream
 }
 
-func (x *tweetServiceListServer) Send(m *ListTweetResponse) error {
+func (x *tweetServiceListBookmarkFoldersServer) Send(m *ListBookmarkFoldersResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TweetService_DeleteBookmarkFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookmarkFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).DeleteBookmarkFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/DeleteBookmarkFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).DeleteBookmarkFolder(ctx, req.(*DeleteBookmarkFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_PinTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).PinTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/PinTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).PinTweet(ctx, req.(*PinTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_UnpinTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).UnpinTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.tweetService/UnpinTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).UnpinTweet(ctx, req.(*UnpinTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TweetService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.tweetService",
 	HandlerType: (*TweetServiceServer)(nil),
@@ -1018,6 +5597,62 @@ var _TweetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _TweetService_Get_Handler,
 		},
+		{
+			MethodName: "Retweet",
+			Handler:    _TweetService_Retweet_Handler,
+		},
+		{
+			MethodName: "UndoRetweet",
+			Handler:    _TweetService_UndoRetweet_Handler,
+		},
+		{
+			MethodName: "Like",
+			Handler:    _TweetService_Like_Handler,
+		},
+		{
+			MethodName: "Unlike",
+			Handler:    _TweetService_Unlike_Handler,
+		},
+		{
+			MethodName: "CreateScheduledTweet",
+			Handler:    _TweetService_CreateScheduledTweet_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledTweet",
+			Handler:    _TweetService_UpdateScheduledTweet_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTweet",
+			Handler:    _TweetService_CancelScheduledTweet_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _TweetService_Vote_Handler,
+		},
+		{
+			MethodName: "Bookmark",
+			Handler:    _TweetService_Bookmark_Handler,
+		},
+		{
+			MethodName: "Unbookmark",
+			Handler:    _TweetService_Unbookmark_Handler,
+		},
+		{
+			MethodName: "CreateBookmarkFolder",
+			Handler:    _TweetService_CreateBookmarkFolder_Handler,
+		},
+		{
+			MethodName: "DeleteBookmarkFolder",
+			Handler:    _TweetService_DeleteBookmarkFolder_Handler,
+		},
+		{
+			MethodName: "PinTweet",
+			Handler:    _TweetService_PinTweet_Handler,
+		},
+		{
+			MethodName: "UnpinTweet",
+			Handler:    _TweetService_UnpinTweet_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1025,6 +5660,56 @@ var _TweetService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _TweetService_List_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetConversation",
+			Handler:       _TweetService_GetConversation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListLikers",
+			Handler:       _TweetService_ListLikers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListHashtagTweets",
+			Handler:       _TweetService_ListHashtagTweets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchTweets",
+			Handler:       _TweetService_SearchTweets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTweets",
+			Handler:       _TweetService_StreamTweets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListRevisions",
+			Handler:       _TweetService_ListRevisions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListScheduledTweets",
+			Handler:       _TweetService_ListScheduledTweets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadMedia",
+			Handler:       _TweetService_UploadMedia_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ListBookmarks",
+			Handler:       _TweetService_ListBookmarks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListBookmarkFolders",
+			Handler:       _TweetService_ListBookmarkFolders_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "tweet_service.proto",
 }
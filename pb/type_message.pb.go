@@ -31,6 +31,11 @@ const (
 	Type_UNKNOWNE_TYPE Type = 0
 	Type_TWEET         Type = 1
 	Type_FOLLOW        Type = 2
+	Type_REPLY         Type = 3
+	Type_LIKE          Type = 4
+	Type_MENTION       Type = 5
+	Type_POLL          Type = 6
+	Type_RETWEET       Type = 7
 )
 
 // Enum value maps for Type.
@@ -39,11 +44,21 @@ var (
 		0: "UNKNOWNE_TYPE",
 		1: "TWEET",
 		2: "FOLLOW",
+		3: "REPLY",
+		4: "LIKE",
+		5: "MENTION",
+		6: "POLL",
+		7: "RETWEET",
 	}
 	Type_value = map[string]int32{
 		"UNKNOWNE_TYPE": 0,
 		"TWEET":         1,
 		"FOLLOW":        2,
+		"REPLY":         3,
+		"LIKE":          4,
+		"MENTION":       5,
+		"POLL":          6,
+		"RETWEET":       7,
 	}
 )
 
@@ -78,11 +93,15 @@ var File_type_message_proto protoreflect.FileDescriptor
 
 var file_type_message_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x2a, 0x30, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x2a, 0x69, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
 	0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x45, 0x5f, 0x54, 0x59, 0x50,
 	0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x57, 0x45, 0x45, 0x54, 0x10, 0x01, 0x12, 0x0a,
-	0x0a, 0x06, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x06, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45,
+	0x50, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x04, 0x12,
+	0x0b, 0x0a, 0x07, 0x4d, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04,
+	0x50, 0x4f, 0x4c, 0x4c, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x54, 0x57, 0x45, 0x45,
+	0x54, 0x10, 0x07, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.11.4
+// source: visibility_message.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// Visibility users who can see a tweet, the author always can.
+type Visibility int32
+
+const (
+	Visibility_PUBLIC         Visibility = 0
+	Visibility_FOLLOWERS_ONLY Visibility = 1
+	Visibility_MENTIONED_ONLY Visibility = 2
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "PUBLIC",
+		1: "FOLLOWERS_ONLY",
+		2: "MENTIONED_ONLY",
+	}
+	Visibility_value = map[string]int32{
+		"PUBLIC":         0,
+		"FOLLOWERS_ONLY": 1,
+		"MENTIONED_ONLY": 2,
+	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_visibility_message_proto_enumTypes[0].Descriptor()
+}
+
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_visibility_message_proto_enumTypes[0]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_visibility_message_proto_rawDescGZIP(), []int{0}
+}
+
+// ReplyPolicy users who can reply to a tweet, the author always can.
+type ReplyPolicy int32
+
+const (
+	ReplyPolicy_REPLY_EVERYONE  ReplyPolicy = 0
+	ReplyPolicy_REPLY_FOLLOWERS ReplyPolicy = 1
+	ReplyPolicy_REPLY_MENTIONED ReplyPolicy = 2
+)
+
+// Enum value maps for ReplyPolicy.
+var (
+	ReplyPolicy_name = map[int32]string{
+		0: "REPLY_EVERYONE",
+		1: "REPLY_FOLLOWERS",
+		2: "REPLY_MENTIONED",
+	}
+	ReplyPolicy_value = map[string]int32{
+		"REPLY_EVERYONE":  0,
+		"REPLY_FOLLOWERS": 1,
+		"REPLY_MENTIONED": 2,
+	}
+)
+
+func (x ReplyPolicy) Enum() *ReplyPolicy {
+	p := new(ReplyPolicy)
+	*p = x
+	return p
+}
+
+func (x ReplyPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplyPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_visibility_message_proto_enumTypes[1].Descriptor()
+}
+
+func (ReplyPolicy) Type() protoreflect.EnumType {
+	return &file_visibility_message_proto_enumTypes[1]
+}
+
+func (x ReplyPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplyPolicy.Descriptor instead.
+func (ReplyPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_visibility_message_proto_rawDescGZIP(), []int{1}
+}
+
+var File_visibility_message_proto protoreflect.FileDescriptor
+
+var file_visibility_message_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x2a, 0x40,
+	0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0a, 0x0a, 0x06,
+	0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x4c, 0x4c,
+	0x4f, 0x57, 0x45, 0x52, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
+	0x4d, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02,
+	0x2a, 0x4b, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x12, 0x0a, 0x0e, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x52, 0x59, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x5f, 0x46, 0x4f, 0x4c,
+	0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x50, 0x4c,
+	0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x42, 0x06, 0x5a,
+	0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_visibility_message_proto_rawDescOnce sync.Once
+	file_visibility_message_proto_rawDescData = file_visibility_message_proto_rawDesc
+)
+
+func file_visibility_message_proto_rawDescGZIP() []byte {
+	file_visibility_message_proto_rawDescOnce.Do(func() {
+		file_visibility_message_proto_rawDescData = protoimpl.X.CompressGZIP(file_visibility_message_proto_rawDescData)
+	})
+	return file_visibility_message_proto_rawDescData
+}
+
+var file_visibility_message_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_visibility_message_proto_goTypes = []interface{}{
+	(Visibility)(0),  // 0: v1.Visibility
+	(ReplyPolicy)(0), // 1: v1.ReplyPolicy
+}
+var file_visibility_message_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_visibility_message_proto_init() }
+func file_visibility_message_proto_init() {
+	if File_visibility_message_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_visibility_message_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_visibility_message_proto_goTypes,
+		DependencyIndexes: file_visibility_message_proto_depIdxs,
+		EnumInfos:         file_visibility_message_proto_enumTypes,
+	}.Build()
+	File_visibility_message_proto = out.File
+	file_visibility_message_proto_rawDesc = nil
+	file_visibility_message_proto_goTypes = nil
+	file_visibility_message_proto_depIdxs = nil
+}
@@ -7,25 +7,239 @@ CREATE TABLE users(
     username VARCHAR NOT NULL,
     hash_password VARCHAR NOT NULL,
     followee_count INTEGER DEFAULT 0,
-    follower_count INTEGER DEFAULT 0
+    follower_count INTEGER DEFAULT 0,
+    pinned_tweet_id INTEGER,
+    -- set once the home timeline of the user is materialized.
+    home_timeline_ready BOOLEAN NOT NULL DEFAULT false
 );
 
+-- mentions resolve usernames case-insensitively.
+CREATE UNIQUE INDEX users_username_lower_idx ON users (lower(username));
+
+CREATE INDEX users_home_timeline_pending_idx ON users (id) WHERE NOT home_timeline_ready;
+
 CREATE TABLE tweets(
     id SERIAL PRIMARY KEY,
     content VARCHAR NOT NULL,
     created_at TIMESTAMP with time zone DEFAULT now(),
     user_id INTEGER NOT NULL,
+    in_reply_to_tweet_id INTEGER,
+    conversation_id INTEGER,
+    retweet_of_id INTEGER,
+    quoted_tweet_id INTEGER,
+    retweet_count INTEGER DEFAULT 0,
+    like_count INTEGER DEFAULT 0,
+    deleted_at TIMESTAMP with time zone,
+    edited_at TIMESTAMP with time zone,
+    revision_count INTEGER DEFAULT 0,
+    visibility VARCHAR NOT NULL DEFAULT 'PUBLIC',
+    reply_policy VARCHAR NOT NULL DEFAULT 'REPLY_EVERYONE',
+    search_vector tsvector GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (in_reply_to_tweet_id) REFERENCES tweets (id) ON DELETE SET NULL,
+    FOREIGN KEY (retweet_of_id) REFERENCES tweets (id) ON DELETE CASCADE,
+    FOREIGN KEY (quoted_tweet_id) REFERENCES tweets (id) ON DELETE SET NULL
+);
+
+ALTER TABLE users ADD FOREIGN KEY (pinned_tweet_id) REFERENCES tweets (id) ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX tweets_retweet_idx ON tweets (user_id, retweet_of_id) WHERE retweet_of_id IS NOT NULL;
+
+CREATE INDEX tweets_conversation_id_idx ON tweets (conversation_id);
+
+CREATE INDEX tweets_search_vector_idx ON tweets USING GIN (search_vector);
+
+-- drafts and scheduled tweets, drafts have no publish_at, rows are removed
+-- once published.
+CREATE TABLE scheduled_tweets(
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    content VARCHAR NOT NULL,
+    reply_to INTEGER,
+    quote_tweet_id INTEGER,
+    publish_at TIMESTAMP with time zone,
+    error VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP with time zone DEFAULT now(),
+    updated_at TIMESTAMP with time zone DEFAULT now(),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX scheduled_tweets_publish_at_idx ON scheduled_tweets (publish_at) WHERE publish_at IS NOT NULL;
+
+CREATE INDEX scheduled_tweets_user_id_idx ON scheduled_tweets (user_id);
+
+-- uploaded images and videos, contents are stored in a blob store keyed
+-- by sha256.
+CREATE TABLE media(
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    type VARCHAR NOT NULL,
+    content_type VARCHAR NOT NULL,
+    size BIGINT NOT NULL,
+    width INTEGER NOT NULL DEFAULT 0,
+    height INTEGER NOT NULL DEFAULT 0,
+    sha256 VARCHAR NOT NULL,
+    created_at TIMESTAMP with time zone DEFAULT now(),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE tweet_media(
+    tweet_id INTEGER NOT NULL,
+    media_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (tweet_id, position),
+    FOREIGN KEY (tweet_id) REFERENCES tweets (id) ON DELETE CASCADE,
+    FOREIGN KEY (media_id) REFERENCES media (id) ON DELETE CASCADE
+);
+
+-- polls attached to tweets, closed_at is set once voters are notified.
+CREATE TABLE polls(
+    tweet_id INTEGER PRIMARY KEY,
+    closes_at TIMESTAMP with time zone NOT NULL,
+    closed_at TIMESTAMP with time zone,
+    FOREIGN KEY (tweet_id) REFERENCES tweets (id) ON DELETE CASCADE
+);
+
+CREATE INDEX polls_closes_at_idx ON polls (closes_at) WHERE closed_at IS NULL;
+
+CREATE TABLE poll_options(
+    tweet_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    text VARCHAR NOT NULL,
+    votes INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (tweet_id, position),
+    FOREIGN KEY (tweet_id) REFERENCES polls (tweet_id) ON DELETE CASCADE
+);
+
+CREATE TABLE poll_votes(
+    tweet_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    created_at TIMESTAMP with time zone DEFAULT now(),
+    PRIMARY KEY (tweet_id, user_id),
+    FOREIGN KEY (tweet_id, position) REFERENCES poll_options (tweet_id, position) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- previous contents of edited tweets.
+CREATE TABLE tweet_revisions(
+    id SERIAL PRIMARY KEY,
+    tweet_id INTEGER NOT NULL,
+    revision INTEGER NOT NULL,
+    content VARCHAR NOT NULL,
+    created_at TIMESTAMP with time zone NOT NULL,
+    FOREIGN KEY (tweet_id) REFERENCES tweets (id) ON DELETE CASCADE,
+    UNIQUE (tweet_id, revision)
+);
+
+CREATE TABLE tweet_hashtags(
+    id SERIAL PRIMARY KEY,
+    tweet_id INTEGER NOT NULL,
+    tag VARCHAR NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    rune_start INTEGER NOT NULL,
+    rune_end INTEGER NOT NULL,
+    FOREIGN KEY (tweet_id) REFERENCES tweets (id) ON DELETE CASCADE
+);
+
+CREATE INDEX tweet_hashtags_tag_idx ON tweet_hashtags (lower(tag), tweet_id);
+CREATE INDEX tweet_hashtags_tweet_id_idx ON tweet_hashtags (tweet_id);
+
+CREATE TABLE tweet_mentions(
+    id SERIAL PRIMARY KEY,
+    tweet_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    username VARCHAR NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    rune_start INTEGER NOT NULL,
+    rune_end INTEGER NOT NULL,
+    FOREIGN KEY (tweet_id) REFERENCES tweets (id) ON DELETE CASCADE,
     FOREIGN KEY (user_id) REFERENCES users (id)
 );
 
+CREATE INDEX tweet_mentions_user_id_idx ON tweet_mentions (user_id, tweet_id);
+CREATE INDEX tweet_mentions_tweet_id_idx ON tweet_mentions (tweet_id);
+
+CREATE TABLE tweet_urls(
+    id SERIAL PRIMARY KEY,
+    tweet_id INTEGER NOT NULL,
+    url VARCHAR NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    rune_start INTEGER NOT NULL,
+    rune_end INTEGER NOT NULL,
+    FOREIGN KEY (tweet_id) REFERENCES tweets (id) ON DELETE CASCADE
+);
+
+CREATE INDEX tweet_urls_tweet_id_idx ON tweet_urls (tweet_id);
+
+CREATE TABLE likes(
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    tweet_id INTEGER NOT NULL,
+    created_at TIMESTAMP with time zone DEFAULT now(),
+    UNIQUE (user_id, tweet_id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (tweet_id) REFERENCES tweets (id) ON DELETE CASCADE
+);
+
+CREATE INDEX likes_tweet_id_idx ON likes (tweet_id, id);
+
+-- private bookmark folders.
+CREATE TABLE bookmark_folders(
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    name VARCHAR NOT NULL,
+    created_at TIMESTAMP with time zone DEFAULT now(),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX bookmark_folders_name_idx ON bookmark_folders (user_id, lower(name));
+
+-- private bookmarks, folder_id is NULL for bookmarks outside folders.
+CREATE TABLE bookmarks(
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    tweet_id INTEGER NOT NULL,
+    folder_id INTEGER,
+    created_at TIMESTAMP with time zone DEFAULT now(),
+    UNIQUE (user_id, tweet_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (tweet_id) REFERENCES tweets (id) ON DELETE CASCADE,
+    FOREIGN KEY (folder_id) REFERENCES bookmark_folders (id) ON DELETE CASCADE
+);
+
+CREATE INDEX bookmarks_user_id_idx ON bookmarks (user_id, id);
+
 CREATE TABLE follows(
     id SERIAL PRIMARY KEY,
     followee INTEGER NOT NULL,
     follower INTEGER NOT NULL,
+    UNIQUE (follower, followee),
     FOREIGN KEY (followee) REFERENCES users (id),
     FOREIGN KEY (follower) REFERENCES users (id)
 );
 
+CREATE INDEX follows_followee_idx ON follows (followee, follower);
+
+-- materialized home timelines filled on write by the timeline worker,
+-- tweets of authors with many followers are merged on read instead. The
+-- worker also backfills the users whose home timeline is not ready.
+CREATE TABLE home_timelines(
+    user_id INTEGER NOT NULL,
+    tweet_id INTEGER NOT NULL,
+    created_at TIMESTAMP with time zone NOT NULL,
+    PRIMARY KEY (user_id, tweet_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (tweet_id) REFERENCES tweets (id) ON DELETE CASCADE
+);
+
+CREATE INDEX home_timelines_user_id_idx ON home_timelines (user_id, created_at DESC, tweet_id DESC);
+
+CREATE INDEX home_timelines_tweet_id_idx ON home_timelines (tweet_id);
+
 CREATE TABLE notifications(
     id SERIAL PRIMARY KEY,
     user_origin INTEGER NOT NULL,
@@ -36,4 +250,38 @@ CREATE TABLE notifications(
     opened BOOLEAN NOT NULL,
     FOREIGN KEY (user_origin) REFERENCES users (id),
     FOREIGN KEY (user_id) REFERENCES users (id)
-);
\ No newline at end of file
+);
+
+-- responses of write requests sent with an idempotency key, replayed on
+-- retries until expires_at. keys of requests in progress have no response
+-- and expire after a short lease.
+CREATE TABLE idempotency_keys(
+    user_id INTEGER NOT NULL,
+    key VARCHAR NOT NULL,
+    method VARCHAR NOT NULL,
+    request_hash VARCHAR NOT NULL,
+    response_type VARCHAR,
+    response BYTEA,
+    expires_at TIMESTAMP with time zone NOT NULL,
+    PRIMARY KEY (user_id, key, method)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+-- tweet events written in the same transaction as the change they
+-- describe, published in order per tweet by the outbox relay.
+CREATE TABLE tweet_outbox(
+    id BIGSERIAL PRIMARY KEY,
+    aggregate_id INTEGER NOT NULL,
+    payload TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP with time zone NOT NULL DEFAULT now(),
+    locked_until TIMESTAMP with time zone,
+    created_at TIMESTAMP with time zone NOT NULL DEFAULT now(),
+    sent_at TIMESTAMP with time zone
+);
+
+CREATE INDEX tweet_outbox_pending_idx ON tweet_outbox (aggregate_id, id) WHERE sent_at IS NULL;
+
+CREATE INDEX tweet_outbox_sent_at_idx ON tweet_outbox (sent_at) WHERE sent_at IS NOT NULL;
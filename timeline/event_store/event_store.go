@@ -1,8 +1,6 @@
 package eventstore
 
 import (
-	"context"
-
 	"github.com/idirall22/twee/pb"
 )
 
@@ -10,8 +8,16 @@ import (
 type EventStore interface {
 	// Start event store.
 	Start() error
-	// Publish to event store
-	Publish(ctx context.Context, n *pb.TweetEvent) error
+	// SubscribeTweets subscribe to tweet events.
+	SubscribeTweets() (<-chan *pb.TweetEvent, error)
+	// SubscribeLive subscribe to tweet events on every instance, only
+	// events published after the call are received.
+	SubscribeLive() (<-chan *pb.TweetEvent, error)
+	// SubscribeFollows subscribe to follow events.
+	SubscribeFollows() (<-chan *pb.FollowEvent, error)
+	// SubscribeLiveFollows subscribe to follow events on every instance,
+	// only events published after the call are received.
+	SubscribeLiveFollows() (<-chan *pb.FollowEvent, error)
 	// Close event store connection.
 	Close() error
 }
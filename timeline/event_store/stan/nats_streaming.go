@@ -0,0 +1,140 @@
+package tleventstore
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/nats-io/stan.go"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+)
+
+// queueGroup timeline instances share the events of a durable queue.
+const queueGroup = "timeline"
+
+// NatsStreamingEventStore event store.
+type NatsStreamingEventStore struct {
+	cc            stan.Conn
+	tweetSubject  string
+	followSubject string
+	done          chan struct{}
+}
+
+// NewNatsStreamingEventStore create new stan event store.
+func NewNatsStreamingEventStore(tweetSubject, followSubject, clusterID, clientID string,
+	option ...stan.Option) (*NatsStreamingEventStore, error) {
+
+	sc, err := stan.Connect(clusterID, clientID, option...)
+	if err != nil {
+		return nil, fmt.Errorf("Could not connect to nats streaming server: %v", err)
+	}
+
+	return &NatsStreamingEventStore{
+		cc:            sc,
+		tweetSubject:  tweetSubject,
+		followSubject: followSubject,
+		done:          make(chan struct{}),
+	}, nil
+}
+
+// Start event store, it waits for the store to be closed.
+func (e *NatsStreamingEventStore) Start() error {
+	<-e.done
+	return nil
+}
+
+// SubscribeTweets subscribe to tweet events, events published while no
+// timeline instance runs are received once one starts.
+func (e *NatsStreamingEventStore) SubscribeTweets() (<-chan *pb.TweetEvent, error) {
+	events := make(chan *pb.TweetEvent, 128)
+
+	err := e.subscribe(e.tweetSubject, func() proto.Message {
+		return &pb.TweetEvent{}
+	}, func(m proto.Message) {
+		events <- m.(*pb.TweetEvent)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// SubscribeLive subscribe to tweet events, unlike SubscribeTweets every
+// instance receives the events published after the call.
+func (e *NatsStreamingEventStore) SubscribeLive() (<-chan *pb.TweetEvent, error) {
+	events := make(chan *pb.TweetEvent, 128)
+
+	_, err := e.cc.Subscribe(e.tweetSubject, func(msg *stan.Msg) {
+		te := &pb.TweetEvent{}
+		err := common.JSONToProtobufMessage(string(msg.Data), te)
+		if err != nil {
+			log.Printf("Could not parse tweet event: %v", err)
+			return
+		}
+		events <- te
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not subscribe to %s: %v", e.tweetSubject, err)
+	}
+	return events, nil
+}
+
+// SubscribeFollows subscribe to follow events.
+func (e *NatsStreamingEventStore) SubscribeFollows() (<-chan *pb.FollowEvent, error) {
+	events := make(chan *pb.FollowEvent, 128)
+
+	err := e.subscribe(e.followSubject, func() proto.Message {
+		return &pb.FollowEvent{}
+	}, func(m proto.Message) {
+		events <- m.(*pb.FollowEvent)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// SubscribeLiveFollows subscribe to follow events, unlike SubscribeFollows
+// every instance receives the events published after the call.
+func (e *NatsStreamingEventStore) SubscribeLiveFollows() (<-chan *pb.FollowEvent, error) {
+	events := make(chan *pb.FollowEvent, 128)
+
+	_, err := e.cc.Subscribe(e.followSubject, func(msg *stan.Msg) {
+		fe := &pb.FollowEvent{}
+		err := common.JSONToProtobufMessage(string(msg.Data), fe)
+		if err != nil {
+			log.Printf("Could not parse follow event: %v", err)
+			return
+		}
+		events <- fe
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not subscribe to %s: %v", e.followSubject, err)
+	}
+	return events, nil
+}
+
+// subscribe to a subject with a durable queue subscription.
+func (e *NatsStreamingEventStore) subscribe(subject string, new func() proto.Message, found func(m proto.Message)) error {
+	_, err := e.cc.QueueSubscribe(subject, queueGroup, func(msg *stan.Msg) {
+		m := new()
+		err := common.JSONToProtobufMessage(string(msg.Data), m)
+		if err != nil {
+			log.Printf("Could not parse %s event: %v", subject, err)
+			return
+		}
+		found(m)
+	}, stan.DurableName(queueGroup))
+	if err != nil {
+		return fmt.Errorf("Could not subscribe to %s: %v", subject, err)
+	}
+	return nil
+}
+
+// Close event store connection.
+func (e *NatsStreamingEventStore) Close() error {
+	close(e.done)
+	return e.cc.Close()
+}
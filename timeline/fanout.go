@@ -0,0 +1,100 @@
+package timeline
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/idirall22/twee/pb"
+)
+
+const (
+	// fanOutTimeout maximum duration of the handling of an event.
+	fanOutTimeout = time.Second * 10
+	// backfillBatch number of home timelines backfilled at once.
+	backfillBatch = 100
+	// backfillInterval delay between two checks of the home timelines to
+	// backfill, new users are backfilled on the next check.
+	backfillInterval = time.Minute
+)
+
+// backfill materialize the home timelines of the existing follows until
+// the server is closed.
+func (s *Server) backfill() {
+	ticker := time.NewTicker(backfillInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), fanOutTimeout)
+			n, err := s.timelineStore.BackfillHomes(ctx, backfillBatch)
+			cancel()
+			if err != nil {
+				log.Printf("Could not backfill home timelines: %v", err)
+			}
+			if err != nil || n < backfillBatch {
+				break
+			}
+		}
+
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// fanOut keep home timelines up to date from tweet and follow events until
+// the server is closed.
+func (s *Server) fanOut(tweets <-chan *pb.TweetEvent, follows <-chan *pb.FollowEvent) {
+	for {
+		select {
+		case <-s.done:
+			return
+		case e := <-tweets:
+			err := s.handleTweetEvent(e)
+			if err != nil {
+				log.Printf("Could not fan out tweet %d: %v", e.TweetId, err)
+			}
+		case e := <-follows:
+			err := s.handleFollowEvent(e)
+			if err != nil {
+				log.Printf("Could not update timeline of user %d: %v", e.Follower, err)
+			}
+		}
+	}
+}
+
+// handleTweetEvent push created tweets and retweets to the followers home
+// timelines and remove deleted ones.
+func (s *Server) handleTweetEvent(e *pb.TweetEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), fanOutTimeout)
+	defer cancel()
+
+	switch e.Action {
+	case pb.Action_CREATED:
+		if e.Type != pb.Type_UNKNOWNE_TYPE && e.Type != pb.Type_TWEET && e.Type != pb.Type_RETWEET {
+			return nil
+		}
+		return s.timelineStore.FanOut(ctx, e.TweetId)
+	case pb.Action_DELETED:
+		return s.timelineStore.RemoveTweet(ctx, e.TweetId)
+	}
+	return nil
+}
+
+// handleFollowEvent backfill the follower home timeline on follow and
+// trim it on unfollow.
+func (s *Server) handleFollowEvent(e *pb.FollowEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), fanOutTimeout)
+	defer cancel()
+
+	switch e.Action {
+	case pb.Action_CREATED:
+		return s.timelineStore.Backfill(ctx, e.Follower, e.Followee)
+	case pb.Action_DELETED:
+		return s.timelineStore.Trim(ctx, e.Follower, e.Followee)
+	}
+	return nil
+}
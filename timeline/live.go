@@ -0,0 +1,147 @@
+package timeline
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/pb"
+)
+
+const (
+	// liveHeartbeat delay between two heartbeats of an idle live stream,
+	// new tweets whose event was missed are also sent on heartbeats.
+	liveHeartbeat = time.Second * 15
+	// liveBatch maximum number of tweets listed at once by live streams.
+	liveBatch int32 = 100
+)
+
+// liveHub wake the live streams following an author when it tweets.
+type liveHub struct {
+	mu   sync.Mutex
+	subs map[int64]map[*liveSub]bool
+}
+
+// liveSub a live stream subscription.
+type liveSub struct {
+	authors []int64
+	// c receive a value when a followed author tweets, wake ups are
+	// merged while the stream is busy.
+	c chan struct{}
+}
+
+func newLiveHub() *liveHub {
+	return &liveHub{subs: map[int64]map[*liveSub]bool{}}
+}
+
+// subscribe to the tweets of authors.
+func (h *liveHub) subscribe(authors []int64) *liveSub {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &liveSub{authors: authors, c: make(chan struct{}, 1)}
+	for _, id := range authors {
+		if h.subs[id] == nil {
+			h.subs[id] = map[*liveSub]bool{}
+		}
+		h.subs[id][sub] = true
+	}
+	return sub
+}
+
+// unsubscribe remove a subscription.
+func (h *liveHub) unsubscribe(sub *liveSub) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, id := range sub.authors {
+		delete(h.subs[id], sub)
+		if len(h.subs[id]) == 0 {
+			delete(h.subs, id)
+		}
+	}
+}
+
+// notify wake the subscriptions following an author.
+func (h *liveHub) notify(authorID int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs[authorID] {
+		select {
+		case sub.c <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// dispatch wake the live streams on new tweets until the server is closed.
+func (s *Server) dispatch(events <-chan *pb.TweetEvent) {
+	for {
+		select {
+		case <-s.done:
+			return
+		case e := <-events:
+			if e.Action != pb.Action_CREATED {
+				continue
+			}
+			if e.Type != pb.Type_UNKNOWNE_TYPE && e.Type != pb.Type_TWEET && e.Type != pb.Type_RETWEET {
+				continue
+			}
+			s.live.notify(e.UserId)
+		}
+	}
+}
+
+// followLive send the tweets published after lastID as they are published,
+// until the client leaves.
+func (s *Server) followLive(stream pb.TimelineService_TimelineServer, viewerID, userID int64,
+	followList []*pb.Follow, lastID int64) error {
+
+	authors := []int64{userID}
+	for _, f := range followList {
+		authors = append(authors, f.Followee)
+	}
+
+	sub := s.live.subscribe(authors)
+	defer s.live.unsubscribe(sub)
+
+	heartbeat := time.NewTicker(liveHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		// keep listing while full batches are found.
+		for {
+			var n int32
+			err := s.timelineStore.ListSince(
+				stream.Context(), viewerID, userID, followList, lastID, liveBatch,
+				func(tweet *pb.Tweet) error {
+					n++
+					lastID = tweet.Id
+					return stream.Send(&pb.TimelineResponse{Tweet: tweet})
+				},
+			)
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not list new tweets: %v", err)
+			}
+			if n < liveBatch {
+				break
+			}
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-s.done:
+			return status.Errorf(codes.Unavailable, "Timeline service is shutting down")
+		case <-sub.c:
+		case <-heartbeat.C:
+			err := stream.Send(&pb.TimelineResponse{Heartbeat: true})
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not send heartbeat: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package timeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiveHub(t *testing.T) {
+	hub := newLiveHub()
+
+	home := hub.subscribe([]int64{1, 2, 3})
+	owner := hub.subscribe([]int64{2})
+
+	// wake ups are merged while the stream is busy.
+	hub.notify(2)
+	hub.notify(2)
+	require.Len(t, home.c, 1)
+	require.Len(t, owner.c, 1)
+	<-home.c
+	<-owner.c
+
+	hub.notify(4)
+	require.Len(t, home.c, 0)
+
+	hub.unsubscribe(owner)
+	hub.notify(2)
+	require.Len(t, home.c, 1)
+	require.Len(t, owner.c, 0)
+
+	hub.unsubscribe(home)
+	require.Empty(t, hub.subs)
+}
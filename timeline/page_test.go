@@ -0,0 +1,68 @@
+package timeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/cache"
+	memorycache "github.com/idirall22/twee/cache/memory"
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/timeline/store"
+)
+
+// countingStore list one tweet of user 2 and count the lookups.
+type countingStore struct {
+	store.TimelineStore
+	lists int
+}
+
+func (s *countingStore) List(ctx context.Context, viewerID, userID int64, followList []*pb.Follow,
+	self pb.TimelineType, page *common.Page, found func(tweet *pb.Tweet) error) (*common.PageCursor, error) {
+	s.lists++
+	return nil, found(&pb.Tweet{Id: 1, UserId: "2"})
+}
+
+func TestListPage(t *testing.T) {
+	ts := &countingStore{}
+	c := memorycache.NewMemoryCache(10, time.Minute)
+	server := &Server{timelineStore: ts, cache: c, cachePages: true}
+
+	follows := []*pb.Follow{{Follower: 1, Followee: 2}, {Follower: 1, Followee: 3}}
+	list := func(page *common.Page) {
+		p, err := server.listPage(context.Background(), 1, 1, follows, pb.TimelineType_HOME, page)
+		require.NoError(t, err)
+		require.Len(t, p.tweets, 1)
+	}
+
+	list(&common.Page{Limit: 20})
+	list(&common.Page{Limit: 20})
+	require.Equal(t, 1, ts.lists)
+
+	// only first pages are cached.
+	list(&common.Page{Limit: 20, MaxID: 5})
+	require.Equal(t, 2, ts.lists)
+
+	// a followee tweet evicts the page.
+	c.Invalidate(cache.TweetEventTags(&pb.TweetEvent{Action: pb.Action_CREATED, UserId: 3})...)
+	list(&common.Page{Limit: 20})
+	require.Equal(t, 3, ts.lists)
+
+	// the viewer follows evict the page.
+	c.Invalidate(cache.FollowEventTags(&pb.FollowEvent{Action: pb.Action_CREATED, Follower: 1, Followee: 4})...)
+	list(&common.Page{Limit: 20})
+	require.Equal(t, 4, ts.lists)
+
+	// unrelated authors do not.
+	c.Invalidate(cache.TweetEventTags(&pb.TweetEvent{Action: pb.Action_CREATED, UserId: 5})...)
+	list(&common.Page{Limit: 20})
+	require.Equal(t, 4, ts.lists)
+
+	// pages are not cached without events evicting them.
+	server.cachePages = false
+	list(&common.Page{Limit: 20})
+	require.Equal(t, 5, ts.lists)
+}
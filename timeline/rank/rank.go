@@ -0,0 +1,119 @@
+package rank
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/idirall22/twee/pb"
+)
+
+// Candidate a tweet that may be shown in a ranked timeline.
+type Candidate struct {
+	Tweet     *pb.Tweet
+	AuthorID  int64
+	CreatedAt time.Time
+	// SecondDegree the author is not followed by the viewer but by one of
+	// its followees.
+	SecondDegree bool
+	// Affinity number of interactions of the viewer with the author, likes,
+	// replies, retweets and quotes.
+	Affinity int32
+}
+
+// Scored a scored candidate.
+type Scored struct {
+	*Candidate
+	Score  float64
+	Reason pb.RankReason
+}
+
+// Scorer score a candidate at a given time, a higher score ranks first.
+type Scorer interface {
+	Score(now time.Time, c *Candidate) (float64, pb.RankReason)
+}
+
+// WeightedScorer score candidates by recency decay, engagement and author
+// affinity.
+type WeightedScorer struct {
+	// HalfLife age at which the recency of a tweet is halved.
+	HalfLife time.Duration
+	// LikeWeight weight of a like in the engagement.
+	LikeWeight float64
+	// RetweetWeight weight of a retweet in the engagement.
+	RetweetWeight float64
+	// AffinityWeight weight of the author affinity.
+	AffinityWeight float64
+	// SecondDegreeFactor multiply the score of second degree candidates.
+	SecondDegreeFactor float64
+}
+
+// DefaultScorer default weighted scorer.
+var DefaultScorer = &WeightedScorer{
+	HalfLife:           time.Hour * 6,
+	LikeWeight:         1,
+	RetweetWeight:      2,
+	AffinityWeight:     1,
+	SecondDegreeFactor: 0.5,
+}
+
+// Score a candidate, recency = 0.5^(age/half life) and
+// score = recency * (1 + log(1+engagement) + affinity weight * log(1+affinity)).
+func (w *WeightedScorer) Score(now time.Time, c *Candidate) (float64, pb.RankReason) {
+	age := now.Sub(c.CreatedAt)
+	if age < 0 {
+		age = 0
+	}
+	recency := math.Pow(0.5, float64(age)/float64(w.HalfLife))
+
+	engagement := math.Log1p(float64(c.Tweet.LikeCount)*w.LikeWeight + float64(c.Tweet.RetweetCount)*w.RetweetWeight)
+	affinity := w.AffinityWeight * math.Log1p(float64(c.Affinity))
+
+	score := recency * (1 + engagement + affinity)
+	if c.SecondDegree {
+		score *= w.SecondDegreeFactor
+	}
+
+	// the reason is the term weighting the most on the score.
+	switch {
+	case c.SecondDegree:
+		return score, pb.RankReason_REASON_SECOND_DEGREE
+	case affinity > 1 && affinity >= engagement:
+		return score, pb.RankReason_REASON_AFFINITY
+	case engagement > 1:
+		return score, pb.RankReason_REASON_ENGAGEMENT
+	}
+	return score, pb.RankReason_REASON_FOLLOWED
+}
+
+// Rank score candidates and return at most limit of them, best first, with
+// no more than maxPerAuthor tweets by author. Equal scores rank the newest
+// tweet first so the order is deterministic.
+func Rank(now time.Time, scorer Scorer, candidates []*Candidate, maxPerAuthor, limit int) []*Scored {
+	scored := make([]*Scored, 0, len(candidates))
+	for _, c := range candidates {
+		score, reason := scorer.Score(now, c)
+		scored = append(scored, &Scored{Candidate: c, Score: score, Reason: reason})
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].Score != scored[j].Score {
+			return scored[i].Score > scored[j].Score
+		}
+		return scored[i].Tweet.Id > scored[j].Tweet.Id
+	})
+
+	ranked := []*Scored{}
+	perAuthor := map[int64]int{}
+	for _, s := range scored {
+		if len(ranked) == limit {
+			break
+		}
+		if perAuthor[s.AuthorID] == maxPerAuthor {
+			continue
+		}
+		perAuthor[s.AuthorID]++
+		ranked = append(ranked, s)
+	}
+	return ranked
+}
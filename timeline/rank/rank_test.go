@@ -0,0 +1,78 @@
+package rank_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/timeline/rank"
+)
+
+var now = time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+// candidate build a fixture candidate published age before now.
+func candidate(id, authorID int64, age time.Duration, likes, retweets uint32, affinity int32, secondDegree bool) *rank.Candidate {
+	return &rank.Candidate{
+		Tweet:        &pb.Tweet{Id: id, LikeCount: likes, RetweetCount: retweets},
+		AuthorID:     authorID,
+		CreatedAt:    now.Add(-age),
+		SecondDegree: secondDegree,
+		Affinity:     affinity,
+	}
+}
+
+func TestWeightedScorer(t *testing.T) {
+	scorer := rank.DefaultScorer
+
+	fresh, reason := scorer.Score(now, candidate(1, 1, 0, 0, 0, 0, false))
+	require.Equal(t, 1.0, fresh)
+	require.Equal(t, pb.RankReason_REASON_FOLLOWED, reason)
+
+	// the recency is halved every half life.
+	old, _ := scorer.Score(now, candidate(2, 1, scorer.HalfLife, 0, 0, 0, false))
+	require.InDelta(t, 0.5, old, 1e-9)
+
+	_, reason = scorer.Score(now, candidate(3, 1, 0, 50, 10, 0, false))
+	require.Equal(t, pb.RankReason_REASON_ENGAGEMENT, reason)
+
+	_, reason = scorer.Score(now, candidate(4, 1, 0, 1, 0, 20, false))
+	require.Equal(t, pb.RankReason_REASON_AFFINITY, reason)
+
+	second, reason := scorer.Score(now, candidate(5, 1, 0, 0, 0, 0, true))
+	require.Equal(t, 0.5, second)
+	require.Equal(t, pb.RankReason_REASON_SECOND_DEGREE, reason)
+}
+
+func TestRank(t *testing.T) {
+	candidates := []*rank.Candidate{
+		candidate(1, 1, time.Hour*24, 0, 0, 0, false),
+		candidate(2, 1, time.Minute, 100, 20, 0, false),
+		candidate(3, 1, time.Minute*2, 80, 10, 0, false),
+		candidate(4, 1, time.Minute*3, 60, 5, 0, false),
+		candidate(5, 2, time.Hour, 0, 0, 30, false),
+		candidate(6, 3, time.Minute, 0, 0, 0, true),
+		candidate(7, 4, time.Minute*30, 0, 0, 0, false),
+		candidate(8, 5, time.Minute*30, 0, 0, 0, false),
+	}
+
+	ranked := rank.Rank(now, rank.DefaultScorer, candidates, 2, 5)
+
+	ids := []int64{}
+	for _, s := range ranked {
+		ids = append(ids, s.Tweet.Id)
+	}
+	// author 1 is limited to its two best tweets, equal scores rank the
+	// newest tweet first.
+	require.Equal(t, []int64{2, 3, 5, 8, 7}, ids)
+	require.Equal(t, pb.RankReason_REASON_ENGAGEMENT, ranked[0].Reason)
+	require.Equal(t, pb.RankReason_REASON_AFFINITY, ranked[2].Reason)
+	require.Equal(t, pb.RankReason_REASON_FOLLOWED, ranked[3].Reason)
+
+	// ranking is deterministic.
+	again := rank.Rank(now, rank.DefaultScorer, candidates, 2, 5)
+	for i := range ranked {
+		require.Equal(t, ranked[i].Tweet.Id, again[i].Tweet.Id)
+	}
+}
@@ -0,0 +1,46 @@
+package timeline
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/timeline/rank"
+)
+
+const (
+	// rankWindow age of the oldest tweets ranked.
+	rankWindow = time.Hour * 72
+	// rankCandidates maximum number of tweets scored at once.
+	rankCandidates int32 = 500
+	// rankMaxPerAuthor maximum number of tweets of an author in a ranked
+	// timeline.
+	rankMaxPerAuthor = 3
+)
+
+// SetScorer set the scorer of ranked timelines.
+func (s *Server) SetScorer(scorer rank.Scorer) {
+	s.scorer = scorer
+}
+
+// ranked send the best recent tweets of the viewer followees and second
+// degree accounts with the reason they are shown.
+func (s *Server) ranked(stream pb.TimelineService_TimelineServer, viewerID int64, followList []*pb.Follow,
+	pageSize int32) error {
+
+	now := time.Now()
+	candidates, err := s.timelineStore.Candidates(stream.Context(), viewerID, followList, now.Add(-rankWindow), rankCandidates)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not list candidates: %v", err)
+	}
+
+	for _, scored := range rank.Rank(now, s.scorer, candidates, rankMaxPerAuthor, int(pageSize)) {
+		err := stream.Send(&pb.TimelineResponse{Tweet: scored.Tweet, Reason: scored.Reason})
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not send tweet: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package tlpostgresstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// FanOut push a tweet to its author followers home timelines, authors with
+// at least fanOutThreshold followers are skipped.
+func (s *PostgresTimelineStore) FanOut(ctx context.Context, tweetID int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx, `
+		INSERT INTO home_timelines (user_id, tweet_id, created_at)
+		SELECT f.follower, t.id, t.created_at
+		FROM tweets t
+		JOIN users u ON u.id = t.user_id
+		JOIN follows f ON f.followee = t.user_id
+		WHERE t.id = $1 AND t.deleted_at IS NULL AND u.follower_count < $2
+		ON CONFLICT (user_id, tweet_id) DO NOTHING`,
+		tweetID, s.fanOutThreshold,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not fan out tweet: %v", err)
+	}
+
+	err = s.trim(ctx, tx, `
+		SELECT f.follower FROM tweets t JOIN follows f ON f.followee = t.user_id
+		WHERE t.id = $2`,
+		tweetID,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Could not commit transaction: %v", err)
+	}
+	return nil
+}
+
+// RemoveTweet remove a tweet from every home timeline.
+func (s *PostgresTimelineStore) RemoveTweet(ctx context.Context, tweetID int64) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM home_timelines WHERE tweet_id=$1", tweetID)
+	if err != nil {
+		return fmt.Errorf("Could not remove tweet from timelines: %v", err)
+	}
+	return nil
+}
+
+// Backfill push the most recent tweets of a followee to the follower home
+// timeline, unless the followee tweets are merged at read time.
+func (s *PostgresTimelineStore) Backfill(ctx context.Context, follower, followee int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx, `
+		INSERT INTO home_timelines (user_id, tweet_id, created_at)
+		SELECT $1, t.id, t.created_at
+		FROM tweets t
+		JOIN users u ON u.id = t.user_id
+		WHERE t.user_id = $2 AND t.deleted_at IS NULL AND u.follower_count < $3
+		ORDER BY t.created_at DESC, t.id DESC
+		LIMIT $4
+		ON CONFLICT (user_id, tweet_id) DO NOTHING`,
+		follower, followee, s.fanOutThreshold, s.capacity,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not backfill timeline: %v", err)
+	}
+
+	err = s.trim(ctx, tx, "SELECT $2::int", follower)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Could not commit transaction: %v", err)
+	}
+	return nil
+}
+
+// BackfillHomes materialize the home timelines of at most limit users not
+// ready yet from their existing follows, it return the number of users.
+func (s *PostgresTimelineStore) BackfillHomes(ctx context.Context, limit int) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Could not start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(
+		ctx,
+		"SELECT id FROM users WHERE NOT home_timeline_ready ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED",
+		limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("Could not select users: %v", err)
+	}
+	defer rows.Close()
+
+	users := []int64{}
+	for rows.Next() {
+		var id int64
+		err = rows.Scan(&id)
+		if err != nil {
+			return 0, fmt.Errorf("Could not scan user: %v", err)
+		}
+		users = append(users, id)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("Could not iterate users: %v", err)
+	}
+	if len(users) == 0 {
+		return 0, nil
+	}
+
+	_, err = tx.ExecContext(
+		ctx, `
+		INSERT INTO home_timelines (user_id, tweet_id, created_at)
+		SELECT u.id, r.id, r.created_at
+		FROM unnest($1::int[]) AS u (id)
+		CROSS JOIN LATERAL (
+			SELECT t.id, t.created_at FROM follows f
+			JOIN users a ON a.id = f.followee
+			JOIN tweets t ON t.user_id = f.followee
+			WHERE f.follower = u.id AND t.deleted_at IS NULL AND a.follower_count < $2
+			ORDER BY t.created_at DESC, t.id DESC
+			LIMIT $3
+		) r
+		ON CONFLICT (user_id, tweet_id) DO NOTHING`,
+		pq.Array(users), s.fanOutThreshold, s.capacity,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("Could not backfill timelines: %v", err)
+	}
+
+	err = s.trim(ctx, tx, "SELECT unnest($2::int[])", pq.Array(users))
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE users SET home_timeline_ready=true WHERE id = ANY($1::int[])",
+		pq.Array(users),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("Could not mark timelines ready: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("Could not commit transaction: %v", err)
+	}
+	return len(users), nil
+}
+
+// Trim remove the tweets of a followee from the follower home timeline.
+func (s *PostgresTimelineStore) Trim(ctx context.Context, follower, followee int64) error {
+	_, err := s.db.ExecContext(
+		ctx, `
+		DELETE FROM home_timelines h USING tweets t
+		WHERE h.user_id = $1 AND t.id = h.tweet_id AND t.user_id = $2`,
+		follower, followee,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not trim timeline: %v", err)
+	}
+	return nil
+}
+
+// trim keep the newest capacity tweets of the home timelines of the users
+// selected by users, users receive arg as $2.
+func (s *PostgresTimelineStore) trim(ctx context.Context, tx *sql.Tx, users string, arg interface{}) error {
+	_, err := tx.ExecContext(
+		ctx, `
+		DELETE FROM home_timelines h USING (
+			SELECT e.user_id, e.created_at, e.tweet_id
+			FROM (`+users+`) AS u (user_id)
+			CROSS JOIN LATERAL (
+				SELECT c.user_id, c.created_at, c.tweet_id FROM home_timelines c
+				WHERE c.user_id = u.user_id
+				ORDER BY c.created_at DESC, c.tweet_id DESC
+				OFFSET $1 LIMIT 1
+			) e
+		) edge
+		WHERE h.user_id = edge.user_id AND (h.created_at, h.tweet_id) <= (edge.created_at, edge.tweet_id)`,
+		s.capacity, arg,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not trim timelines: %v", err)
+	}
+	return nil
+}
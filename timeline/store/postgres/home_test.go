@@ -0,0 +1,147 @@
+package tlpostgresstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+)
+
+func TestHomeTimelines(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewPostgresTimelineStore(common.PostgresTestOptions)
+	require.NoError(t, err)
+	// homes keep 2 tweets.
+	s.SetFanOut(10, 2)
+	s.SetMaterialized(true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	author := newUser(t, s, true)
+	follower1 := newUser(t, s, true)
+	follower2 := newUser(t, s, true)
+	follow(t, s, follower1, author)
+	follow(t, s, follower2, author)
+
+	// tweets are fanned out to the followers.
+	tweet1 := newTweet(t, s, author)
+	require.NoError(t, s.FanOut(ctx, tweet1))
+	require.Equal(t, []int64{tweet1}, homeTweets(t, s, follower1))
+	require.Equal(t, []int64{tweet1}, homeTweets(t, s, follower2))
+	require.Empty(t, homeTweets(t, s, author))
+
+	// homes are trimmed to the newest tweets at the cap.
+	tweet2 := newTweet(t, s, author)
+	require.NoError(t, s.FanOut(ctx, tweet2))
+	tweet3 := newTweet(t, s, author)
+	require.NoError(t, s.FanOut(ctx, tweet3))
+	require.Equal(t, []int64{tweet3, tweet2}, homeTweets(t, s, follower1))
+	require.Equal(t, []int64{tweet3, tweet2}, homeTweets(t, s, follower2))
+
+	// deleted tweets are removed from every home.
+	_, err = s.db.Exec("UPDATE tweets SET deleted_at=now() WHERE id=$1", tweet3)
+	require.NoError(t, err)
+	require.NoError(t, s.RemoveTweet(ctx, tweet3))
+	require.Equal(t, []int64{tweet2}, homeTweets(t, s, follower1))
+	require.Equal(t, []int64{tweet2}, homeTweets(t, s, follower2))
+
+	// unfollowing removes the followee tweets.
+	require.NoError(t, s.Trim(ctx, follower1, author))
+	require.Empty(t, homeTweets(t, s, follower1))
+	require.Equal(t, []int64{tweet2}, homeTweets(t, s, follower2))
+
+	// following backfills the newest followee tweets.
+	require.NoError(t, s.Backfill(ctx, follower1, author))
+	require.Equal(t, []int64{tweet2, tweet1}, homeTweets(t, s, follower1))
+
+	// existing follows are backfilled once.
+	pending := newUser(t, s, false)
+	follow(t, s, pending, author)
+	for !homeReady(t, s, pending) {
+		_, err := s.BackfillHomes(ctx, 100)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []int64{tweet2, tweet1}, homeTweets(t, s, pending))
+
+	// tweets of authors with 3 followers or more are not fanned out, they
+	// are read from the followees.
+	s.SetFanOut(3, 2)
+	tweet4 := newTweet(t, s, author)
+	require.NoError(t, s.FanOut(ctx, tweet4))
+	require.Equal(t, []int64{tweet2, tweet1}, homeTweets(t, s, pending))
+
+	ids := []int64{}
+	_, err = s.List(ctx, pending, pending, []*pb.Follow{{Follower: pending, Followee: author}},
+		pb.TimelineType_HOME, &common.Page{Limit: 10}, func(tweet *pb.Tweet) error {
+			ids = append(ids, tweet.Id)
+			return nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, []int64{tweet4, tweet2, tweet1}, ids)
+}
+
+// newUser create a user with a unique username.
+func newUser(t *testing.T, s *PostgresTimelineStore, ready bool) int64 {
+	var id int64
+	err := s.db.QueryRow(
+		"INSERT INTO users (username, hash_password, home_timeline_ready) VALUES ($1, 'hash', $2) RETURNING id",
+		fmt.Sprintf("home-%d", time.Now().UnixNano()), ready,
+	).Scan(&id)
+	require.NoError(t, err)
+	return id
+}
+
+// follow make follower follow followee and update their counts.
+func follow(t *testing.T, s *PostgresTimelineStore, follower, followee int64) {
+	_, err := s.db.Exec("INSERT INTO follows (follower, followee) VALUES ($1, $2)", follower, followee)
+	require.NoError(t, err)
+	_, err = s.db.Exec("UPDATE users SET follower_count=follower_count+1 WHERE id=$1", followee)
+	require.NoError(t, err)
+	_, err = s.db.Exec("UPDATE users SET followee_count=followee_count+1 WHERE id=$1", follower)
+	require.NoError(t, err)
+}
+
+// newTweet create a public tweet.
+func newTweet(t *testing.T, s *PostgresTimelineStore, userID int64) int64 {
+	var id int64
+	err := s.db.QueryRow(
+		"INSERT INTO tweets (content, user_id) VALUES ('hello', $1) RETURNING id",
+		userID,
+	).Scan(&id)
+	require.NoError(t, err)
+	return id
+}
+
+// homeTweets return the tweets of a home timeline newest first.
+func homeTweets(t *testing.T, s *PostgresTimelineStore, userID int64) []int64 {
+	rows, err := s.db.Query(
+		"SELECT tweet_id FROM home_timelines WHERE user_id=$1 ORDER BY created_at DESC, tweet_id DESC",
+		userID,
+	)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	ids := []int64{}
+	for rows.Next() {
+		var id int64
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(t, rows.Err())
+	return ids
+}
+
+// homeReady return true once the home timeline of a user is backfilled.
+func homeReady(t *testing.T, s *PostgresTimelineStore, userID int64) bool {
+	var ready bool
+	err := s.db.QueryRow("SELECT home_timeline_ready FROM users WHERE id=$1", userID).Scan(&ready)
+	require.NoError(t, err)
+	return ready
+}
@@ -12,12 +12,24 @@ import (
 	"github.com/idirall22/twee/common"
 	option "github.com/idirall22/twee/options"
 	"github.com/idirall22/twee/pb"
+	postgresstore "github.com/idirall22/twee/tweet/store/postgres"
+)
+
+const (
+	// defaultFanOutThreshold number of followers from which the tweets of
+	// an author are merged at read time instead of fanned out.
+	defaultFanOutThreshold = 10000
+	// defaultCapacity maximum number of tweets kept in a home timeline.
+	defaultCapacity = 800
 )
 
 // PostgresTimelineStore timeline postgres store struct
 type PostgresTimelineStore struct {
-	options *option.PostgresOptions
-	db      *sql.DB
+	options         *option.PostgresOptions
+	db              *sql.DB
+	fanOutThreshold int32
+	capacity        int
+	materialized    bool
 }
 
 // NewPostgresTimelineStore create new Timeline postgres store
@@ -28,55 +40,182 @@ func NewPostgresTimelineStore(opts *option.PostgresOptions) (*PostgresTimelineSt
 	}
 
 	return &PostgresTimelineStore{
-		options: opts,
-		db:      db,
+		options:         opts,
+		db:              db,
+		fanOutThreshold: defaultFanOutThreshold,
+		capacity:        defaultCapacity,
 	}, nil
 }
 
-// List timeline user tweets
+// SetFanOut set the number of followers from which an author tweets are
+// no longer fanned out and the capacity of home timelines.
+func (s *PostgresTimelineStore) SetFanOut(threshold int32, capacity int) {
+	s.fanOutThreshold = threshold
+	s.capacity = capacity
+}
+
+// SetMaterialized read home timelines from the fanned out tweets, only
+// when a worker fans them out, followees tweets are read directly
+// otherwise.
+func (s *PostgresTimelineStore) SetMaterialized(materialized bool) {
+	s.materialized = materialized
+}
+
+// List a page of timeline tweets newest first, it return the cursor of the
+// next page, nil when the page is the last one.
 func (s *PostgresTimelineStore) List(
 	ctx context.Context,
-	userID int64,
+	viewerID, userID int64,
 	followList []*pb.Follow,
 	timelineType pb.TimelineType,
+	page *common.Page,
 	found func(tm *pb.Tweet) error,
-) error {
+) (*common.PageCursor, error) {
 
-	tx, err := s.db.BeginTx(ctx, nil)
+	usersString := strconv.FormatInt(userID, 10)
+	usersString = common.GetFolloweeString(usersString, followList)
+	args := []interface{}{usersString, viewerID}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conds := []string{"t.user_id = ANY($1::int[])"}
+	if timelineType == pb.TimelineType_HOME && s.materialized {
+		conds = []string{fmt.Sprintf(homeAuthors, arg(userID), arg(s.fanOutThreshold))}
+	}
+	conds = append(conds, "t.deleted_at IS NULL", common.TweetVisibleTo("t", "$2"))
+	conds = append(conds, page.Conditions("t", arg)...)
+
+	// the pinned tweet is shown first on the first page only.
+	pinnedFirst := timelineType == pb.TimelineType_OWNER && page.After == nil && page.MaxID == 0 && page.SinceID == 0
+	order := "t.created_at DESC, t.id DESC"
+	if pinnedFirst {
+		order = "pinned DESC, " + order
+	} else if timelineType == pb.TimelineType_OWNER {
+		conds = append(conds, "NOT "+pinnedColumn)
+	}
+
+	query := `
+		SELECT ` + timelineColumns + `
+		FROM tweets t
+		LEFT JOIN tweets o ON o.id = COALESCE(t.retweet_of_id, t.quoted_tweet_id)
+			AND ` + common.TweetVisibleTo("o", "$2") + `
+		WHERE ` + strings.Join(conds, " AND ") + `
+		ORDER BY ` + order + `
+		LIMIT ` + arg(page.Limit)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("Could not start transaction: %v", err)
+		return nil, fmt.Errorf("Could not Query timeline tweets: %v", err)
 	}
 
-	defer tx.Rollback()
+	defer rows.Close()
 
-	usersString := strconv.FormatInt(userID, 10)
-	query := "SELECT * FROM tweets WHERE user_id = ANY($1::int[])"
-	usersString = common.GetFolloweeString(usersString, followList)
-	// if timelineType == pb.TimelineType_HOME {
-	// }
+	// an original tweet is shown once, either itself or its newest retweet.
+	seen := map[int64]bool{}
+	var count int32
+	var next *common.PageCursor
+	tweets := []*pb.Tweet{}
 
-	stmt, err := tx.PrepareContext(ctx, query)
+	for rows.Next() {
+		tweet, err := scanTimelineTweet(rows)
+		if err != nil {
+			return nil, fmt.Errorf("Could not scan tweet: %v", err)
+		}
+
+		count++
+		if !pinnedFirst || !tweet.Pinned {
+			createdAt, err := ptypes.Timestamp(tweet.CreatedAt)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid tweet created at: %v", err)
+			}
+			next = &common.PageCursor{CreatedAt: createdAt, ID: tweet.Id}
+		}
+
+		key := tweet.Id
+		if tweet.RetweetOfId != 0 {
+			key = tweet.RetweetOfId
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		tweets = append(tweets, tweet)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Could not iterate timeline tweets: %v", err)
+	}
+	rows.Close()
+
+	err = postgresstore.HydrateAttachments(ctx, s.db, viewerID, tweets)
 	if err != nil {
-		return fmt.Errorf("Could not prepare statment: %v", err)
+		return nil, err
 	}
 
-	rows, err := stmt.QueryContext(ctx, usersString)
+	for _, tweet := range tweets {
+		err = found(tweet)
+		if err != nil {
+			return nil, fmt.Errorf("Could not send tweet: %v", err)
+		}
+	}
+
+	if count < page.Limit {
+		return nil, nil
+	}
+	return next, nil
+}
+
+// ListSince list the tweets of a user and its followees published after
+// sinceID oldest first, the authors are read directly so tweets not fanned
+// out yet are found.
+func (s *PostgresTimelineStore) ListSince(
+	ctx context.Context,
+	viewerID, userID int64,
+	followList []*pb.Follow,
+	sinceID int64,
+	limit int32,
+	found func(tm *pb.Tweet) error,
+) error {
+
+	usersString := common.GetFolloweeString(strconv.FormatInt(userID, 10), followList)
+
+	rows, err := s.db.QueryContext(
+		ctx, `
+		SELECT `+timelineColumns+`
+		FROM tweets t
+		LEFT JOIN tweets o ON o.id = COALESCE(t.retweet_of_id, t.quoted_tweet_id)
+			AND `+common.TweetVisibleTo("o", "$2")+`
+		WHERE t.user_id = ANY($1::int[]) AND t.id > $3 AND t.deleted_at IS NULL
+			AND `+common.TweetVisibleTo("t", "$2")+`
+		ORDER BY t.id
+		LIMIT $4`,
+		usersString, viewerID, sinceID, limit,
+	)
 	if err != nil {
 		return fmt.Errorf("Could not Query timeline tweets: %v", err)
 	}
-
 	defer rows.Close()
 
+	tweets := []*pb.Tweet{}
 	for rows.Next() {
-		tweet := &pb.Tweet{}
-		var t time.Time
-		rows.Scan(
-			&tweet.Id,
-			&tweet.UserId,
-			&tweet.Content,
-			&t,
-		)
-		tweet.CreatedAt, _ = ptypes.TimestampProto(t)
+		tweet, err := scanTimelineTweet(rows)
+		if err != nil {
+			return fmt.Errorf("Could not scan tweet: %v", err)
+		}
+		tweets = append(tweets, tweet)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("Could not iterate timeline tweets: %v", err)
+	}
+	rows.Close()
+
+	err = postgresstore.HydrateAttachments(ctx, s.db, viewerID, tweets)
+	if err != nil {
+		return err
+	}
+
+	for _, tweet := range tweets {
 		err = found(tweet)
 		if err != nil {
 			return fmt.Errorf("Could not send tweet: %v", err)
@@ -85,6 +224,109 @@ func (s *PostgresTimelineStore) List(
 	return nil
 }
 
+// homeAuthors select the tweets of a home timeline, the user own tweets,
+// the fanned out tweets and the tweets of followed authors with too many
+// followers to be fanned out, or of every followed author until the home
+// timeline is backfilled, formatted with the user id and the fan out
+// threshold placeholders.
+const homeAuthors = `(
+	t.user_id = %[1]s
+	OR t.id IN (SELECT h.tweet_id FROM home_timelines h WHERE h.user_id = %[1]s)
+	OR t.user_id IN (
+		SELECT u.id FROM users u WHERE u.id = ANY($1::int[]) AND (
+			u.follower_count >= %[2]s
+			OR NOT EXISTS(SELECT 1 FROM users r WHERE r.id = %[1]s AND r.home_timeline_ready)
+		)
+	)
+)`
+
+// timelineColumns timeline tweet columns and its retweeted or quoted original.
+const timelineColumns = `
+	t.id, t.user_id, t.content, t.created_at, t.retweet_of_id, t.quoted_tweet_id, t.retweet_count,
+	t.like_count, EXISTS(SELECT 1 FROM likes l WHERE l.tweet_id = t.id AND l.user_id = $2),
+	o.id, o.user_id, o.content, o.created_at, o.retweet_count, o.like_count, o.deleted_at IS NOT NULL,
+	EXISTS(SELECT 1 FROM likes ol WHERE ol.tweet_id = o.id AND ol.user_id = $2),
+	` + pinnedColumn + ` AS pinned`
+
+// pinnedColumn true when a timeline tweet is pinned by its author.
+const pinnedColumn = "EXISTS(SELECT 1 FROM users pu WHERE pu.id = t.user_id AND pu.pinned_tweet_id = t.id)"
+
+// scanTimelineTweet scan a row selected with timelineColumns, followed by
+// the extra columns.
+func scanTimelineTweet(rows *sql.Rows, extra ...interface{}) (*pb.Tweet, error) {
+	tweet := &pb.Tweet{}
+	var t time.Time
+	var retweetOf, quoted sql.NullInt64
+
+	var oID sql.NullInt64
+	var oUserID, oContent sql.NullString
+	var oCreatedAt sql.NullTime
+	var oRetweetCount, oLikeCount sql.NullInt64
+	var oDeleted sql.NullBool
+	var oLiked bool
+
+	dest := []interface{}{
+		&tweet.Id,
+		&tweet.UserId,
+		&tweet.Content,
+		&t,
+		&retweetOf,
+		&quoted,
+		&tweet.RetweetCount,
+		&tweet.LikeCount,
+		&tweet.Liked,
+		&oID,
+		&oUserID,
+		&oContent,
+		&oCreatedAt,
+		&oRetweetCount,
+		&oLikeCount,
+		&oDeleted,
+		&oLiked,
+		&tweet.Pinned,
+	}
+
+	err := rows.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	tweet.CreatedAt, _ = ptypes.TimestampProto(t)
+	tweet.RetweetOfId = retweetOf.Int64
+	tweet.QuotedTweetId = quoted.Int64
+
+	originalID := tweet.RetweetOfId
+	if originalID == 0 {
+		originalID = tweet.QuotedTweetId
+	}
+	if originalID == 0 {
+		return tweet, nil
+	}
+
+	// the original was removed or is hidden from the viewer, keep a tombstone.
+	if !oID.Valid || oDeleted.Bool {
+		tweet.Original = &pb.Tweet{Id: originalID, Deleted: true}
+		return tweet, nil
+	}
+
+	tweet.Original = &pb.Tweet{
+		Id:           oID.Int64,
+		UserId:       oUserID.String,
+		Content:      oContent.String,
+		RetweetCount: uint32(oRetweetCount.Int64),
+		LikeCount:    uint32(oLikeCount.Int64),
+		Liked:        oLiked,
+	}
+	tweet.Original.CreatedAt, _ = ptypes.TimestampProto(oCreatedAt.Time)
+
+	// retweets are liked through their original.
+	if tweet.RetweetOfId != 0 {
+		tweet.LikeCount = tweet.Original.LikeCount
+		tweet.Liked = tweet.Original.Liked
+	}
+	return tweet, nil
+}
+
 func getFolloweeString(in string, followList []*pb.Follow) string {
 	out := in + ","
 	for _, f := range followList {
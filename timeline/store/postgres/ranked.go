@@ -0,0 +1,101 @@
+package tlpostgresstore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/timeline/rank"
+	postgresstore "github.com/idirall22/twee/tweet/store/postgres"
+)
+
+// Candidates list the tweets published after since by the viewer followees
+// and by the accounts they follow, newest first, with the viewer affinity
+// for their author.
+func (s *PostgresTimelineStore) Candidates(
+	ctx context.Context,
+	viewerID int64,
+	followList []*pb.Follow,
+	since time.Time,
+	limit int32,
+) ([]*rank.Candidate, error) {
+
+	followees := make([]int64, 0, len(followList))
+	for _, f := range followList {
+		followees = append(followees, f.Followee)
+	}
+
+	rows, err := s.db.QueryContext(
+		ctx, `
+		WITH second_degree AS (
+			SELECT DISTINCT f.followee AS user_id FROM follows f
+			WHERE f.follower = ANY($1::int[]) AND f.followee <> $2 AND NOT f.followee = ANY($1::int[])
+		), affinity AS (
+			SELECT a.user_id, count(*) AS interactions FROM (
+				SELECT lt.user_id FROM likes l JOIN tweets lt ON lt.id = l.tweet_id
+				WHERE l.user_id = $2
+				UNION ALL
+				SELECT it.user_id FROM tweets r
+				JOIN tweets it ON it.id = COALESCE(r.in_reply_to_tweet_id, r.retweet_of_id, r.quoted_tweet_id)
+				WHERE r.user_id = $2
+			) a GROUP BY a.user_id
+		)
+		SELECT `+timelineColumns+`,
+			t.user_id IN (SELECT user_id FROM second_degree),
+			COALESCE(af.interactions, 0)
+		FROM tweets t
+		LEFT JOIN tweets o ON o.id = COALESCE(t.retweet_of_id, t.quoted_tweet_id)
+			AND `+common.TweetVisibleTo("o", "$2")+`
+		LEFT JOIN affinity af ON af.user_id = t.user_id
+		WHERE (t.user_id = ANY($1::int[]) OR t.user_id IN (SELECT user_id FROM second_degree))
+			AND t.retweet_of_id IS NULL AND t.deleted_at IS NULL AND t.created_at > $3
+			AND `+common.TweetVisibleTo("t", "$2")+`
+		ORDER BY t.created_at DESC, t.id DESC
+		LIMIT $4`,
+		pq.Array(followees), viewerID, since, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not query candidates: %v", err)
+	}
+	defer rows.Close()
+
+	candidates := []*rank.Candidate{}
+	for rows.Next() {
+		c := &rank.Candidate{}
+		c.Tweet, err = scanTimelineTweet(rows, &c.SecondDegree, &c.Affinity)
+		if err != nil {
+			return nil, fmt.Errorf("Could not scan candidate: %v", err)
+		}
+
+		c.CreatedAt, err = ptypes.Timestamp(c.Tweet.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid tweet created at: %v", err)
+		}
+		c.AuthorID, err = strconv.ParseInt(c.Tweet.UserId, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid tweet user id: %v", err)
+		}
+		candidates = append(candidates, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Could not iterate candidates: %v", err)
+	}
+	rows.Close()
+
+	tweets := make([]*pb.Tweet, 0, len(candidates))
+	for _, c := range candidates {
+		tweets = append(tweets, c.Tweet)
+	}
+	err = postgresstore.HydrateAttachments(ctx, s.db, viewerID, tweets)
+	if err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
@@ -2,12 +2,39 @@ package store
 
 import (
 	"context"
+	"time"
 
+	"github.com/idirall22/twee/common"
 	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/timeline/rank"
 )
 
 // TimelineStore timeline interface
 type TimelineStore interface {
-	// List tweets
-	List(ctx context.Context, userID int64, followList []*pb.Follow, self pb.TimelineType, found func(tweet *pb.Tweet) error) error
+	// List a page of tweets newest first, it return the next page cursor,
+	// nil for the last page
+	List(ctx context.Context, viewerID, userID int64, followList []*pb.Follow, self pb.TimelineType,
+		page *common.Page, found func(tweet *pb.Tweet) error) (*common.PageCursor, error)
+	// ListSince list the tweets of a user and its followees published after
+	// sinceID, oldest first
+	ListSince(ctx context.Context, viewerID, userID int64, followList []*pb.Follow, sinceID int64, limit int32,
+		found func(tweet *pb.Tweet) error) error
+	// Candidates list the recent tweets of the viewer followees and second
+	// degree accounts to rank
+	Candidates(ctx context.Context, viewerID int64, followList []*pb.Follow, since time.Time,
+		limit int32) ([]*rank.Candidate, error)
+	// FanOut push a tweet to its author followers home timelines, authors
+	// with many followers are read at list time instead
+	FanOut(ctx context.Context, tweetID int64) error
+	// RemoveTweet remove a tweet from every home timeline
+	RemoveTweet(ctx context.Context, tweetID int64) error
+	// Backfill push the recent tweets of a followee to the follower home timeline
+	Backfill(ctx context.Context, follower, followee int64) error
+	// Trim remove the tweets of a followee from the follower home timeline
+	Trim(ctx context.Context, follower, followee int64) error
+	// BackfillHomes materialize the home timelines of at most limit users
+	// from their existing follows, it return the number of users
+	BackfillHomes(ctx context.Context, limit int) (int, error)
+	// SetMaterialized read home timelines from the fanned out tweets
+	SetMaterialized(materialized bool)
 }
@@ -3,27 +3,49 @@ package timeline
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	eventstore "github.com/idirall22/twee/timeline/event_store"
 
 	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/cache"
+	memorycache "github.com/idirall22/twee/cache/memory"
+	"github.com/idirall22/twee/common"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/timeline/rank"
 	"github.com/idirall22/twee/timeline/store"
 )
 
+// maxPageSize maximum number of tweets listed at once.
+var maxPageSize int32 = 50
+
+const (
+	// followCacheSize maximum number of cached follow lists.
+	followCacheSize = 10000
+	// followCacheTTL duration a follow list is cached, follow events evict
+	// it before when an event store is set.
+	followCacheTTL = time.Second * 30
+)
+
 // Server timeline server service.
 type Server struct {
 	timelineStore      store.TimelineStore
 	notificationClient *pb.NotificationServiceClient
 	eventStore         eventstore.EventStore
 	followClient       pb.FollowServiceClient
+	scorer             rank.Scorer
+	cache              cache.Cache
+	cachePages         bool
+	live               *liveHub
+	done               chan struct{}
 }
 
-// NewTimelineServer create new timeline service.
+// NewTimelineServer create new timeline service, fc must be dialed with
+// the timeline service credentials (auth.NewServiceCredentials).
 func NewTimelineServer(
 	s store.TimelineStore,
 	es eventstore.EventStore,
@@ -38,62 +60,237 @@ func NewTimelineServer(
 		return nil, fmt.Errorf("Follow service should not be nil")
 	}
 
-	// if es == nil {
-	// 	return nil, fmt.Errorf("Event Store should not be NIL")
-	// }
-
-	// go es.Start()
-
-	return &Server{
+	server := &Server{
 		timelineStore: s,
 		eventStore:    es,
 		followClient:  fc,
-	}, nil
+		scorer:        rank.DefaultScorer,
+		cache:         memorycache.NewMemoryCache(followCacheSize, followCacheTTL),
+		done:          make(chan struct{}),
+	}
+
+	// home timelines are only materialized and followed live when an event
+	// store is set.
+	if es != nil {
+		go es.Start()
+
+		s.SetMaterialized(true)
+		go server.backfill()
+
+		tweets, err := es.SubscribeTweets()
+		if err != nil {
+			return nil, fmt.Errorf("Could not subscribe to tweet events: %v", err)
+		}
+
+		follows, err := es.SubscribeFollows()
+		if err != nil {
+			return nil, fmt.Errorf("Could not subscribe to follow events: %v", err)
+		}
+		go server.fanOut(tweets, follows)
+
+		live, err := es.SubscribeLive()
+		if err != nil {
+			return nil, fmt.Errorf("Could not subscribe to live tweet events: %v", err)
+		}
+		server.live = newLiveHub()
+		go server.dispatch(live)
+
+		liveFollows, err := es.SubscribeLiveFollows()
+		if err != nil {
+			return nil, fmt.Errorf("Could not subscribe to live follow events: %v", err)
+		}
+		cacheTweets, err := es.SubscribeLive()
+		if err != nil {
+			return nil, fmt.Errorf("Could not subscribe to live tweet events: %v", err)
+		}
+		server.cachePages = true
+		go cache.Watch(server.cache, cacheTweets, liveFollows, server.done)
+	}
+
+	return server, nil
 }
 
-// Timeline user timeline home or self
+// Timeline user timeline home, ranked or self
 func (s *Server) Timeline(req *pb.TimelineRequest, stream pb.TimelineService_TimelineServer) error {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if req.FollowLive && req.Type == pb.TimelineType_RANKED {
+		return status.Errorf(codes.InvalidArgument, "Ranked timelines can not be followed live")
+	}
+
+	if req.FollowLive && s.live == nil {
+		return status.Errorf(codes.FailedPrecondition, "Live timelines are not available")
+	}
+
+	after, err := common.DecodePageCursor(req.GetPageToken())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
 	userID := req.UserId
 	var followList []*pb.Follow
 
-	if req.Type == pb.TimelineType_HOME {
-		// get user infos from context
-		userInfos, err := auth.GetUserInfosFromContext(stream.Context())
-		if err != nil {
-			return status.Errorf(codes.InvalidArgument, err.Error())
-		}
-		uc := stream.Context().Value(auth.ClaimKey("claims")).(*auth.UserClaims)
+	// home and ranked timelines are always the caller ones.
+	if req.Type == pb.TimelineType_HOME || req.Type == pb.TimelineType_RANKED {
 		userID = userInfos.ID
 
-		ctx := metadata.AppendToOutgoingContext(context.Background(), auth.AuthKey, uc.Token)
-		res, err := s.followClient.ListFollow(ctx, &pb.RequestListFollow{
-			Follower:   req.UserId,
-			FollowType: pb.FollowListType_FOLLOWER,
-		})
-
+		followList, err = s.listFollow(stream.Context(), userID)
 		if err != nil {
 			return status.Errorf(codes.Internal, err.Error())
 		}
-		followList = res.Follows
 	}
 
-	err := s.timelineStore.List(
-		stream.Context(),
-		userID,
-		followList,
-		req.Type,
-		func(tweet *pb.Tweet) error {
+	if req.Type == pb.TimelineType_RANKED {
+		return s.ranked(stream, userInfos.ID, followList, pageSize)
+	}
+
+	// a resumed live stream only receives the tweets it missed.
+	lastID := req.LastId
+	if !req.FollowLive || lastID == 0 {
+		page, err := s.listPage(
+			stream.Context(),
+			userInfos.ID,
+			userID,
+			followList,
+			req.Type,
+			&common.Page{
+				After:   after,
+				MaxID:   req.GetMaxId(),
+				SinceID: req.GetSinceId(),
+				Limit:   pageSize,
+			},
+		)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not list timeline: %v", err)
+		}
+
+		for _, tweet := range page.tweets {
+			if tweet.Id > lastID {
+				lastID = tweet.Id
+			}
 			err := stream.Send(&pb.TimelineResponse{Tweet: tweet})
 			if err != nil {
 				return status.Errorf(codes.Internal, "Could not send tweet: %v", err)
 			}
+		}
+
+		if page.next != nil {
+			err = stream.Send(&pb.TimelineResponse{NextPageToken: page.next.Encode()})
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not send next page token: %v", err)
+			}
+		}
+	}
+
+	if !req.FollowLive {
+		return nil
+	}
+	return s.followLive(stream, userInfos.ID, userID, followList, lastID)
+}
+
+// timelinePage a listed timeline page.
+type timelinePage struct {
+	tweets []*pb.Tweet
+	next   *common.PageCursor
+}
+
+// listPage list a timeline page, first pages are cached when events evict
+// them, until an event of the viewer follows or of one of the page authors.
+func (s *Server) listPage(
+	ctx context.Context,
+	viewerID, userID int64,
+	followList []*pb.Follow,
+	timelineType pb.TimelineType,
+	page *common.Page,
+) (*timelinePage, error) {
+
+	cached := s.cachePages && page.After == nil && page.MaxID == 0 && page.SinceID == 0
+	key := fmt.Sprintf("timeline:%s:%d:%d:%d", timelineType, viewerID, userID, page.Limit)
+	if cached {
+		if v, ok := s.cache.Get(key); ok {
+			return v.(*timelinePage), nil
+		}
+	}
+
+	p := &timelinePage{}
+	next, err := s.timelineStore.List(ctx, viewerID, userID, followList, timelineType, page,
+		func(tweet *pb.Tweet) error {
+			p.tweets = append(p.tweets, tweet)
 			return nil
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	p.next = next
+
+	if cached {
+		s.cache.Set(key, p, pageTags(viewerID, userID, followList, p.tweets)...)
+	}
+	return p, nil
+}
+
+// pageTags return the tags of a timeline page, the viewer follows and the
+// authors of the listed or listable tweets.
+func pageTags(viewerID, userID int64, followList []*pb.Follow, tweets []*pb.Tweet) []string {
+	tags := []string{cache.UserTag(viewerID), cache.AuthorTag(userID)}
+	for _, f := range followList {
+		tags = append(tags, cache.AuthorTag(f.Followee))
+	}
+	for _, t := range tweets {
+		for _, tweet := range []*pb.Tweet{t, t.Original} {
+			if tweet == nil {
+				continue
+			}
+			if id, err := strconv.ParseInt(tweet.UserId, 10, 64); err == nil {
+				tags = append(tags, cache.AuthorTag(id))
+			}
+		}
+	}
+	return tags
+}
 
+// listFollow list the users followed by a user, the list is cached until
+// a follow event of the user or its expiration. The follow client
+// authenticates the timeline service, the caller token is never forwarded.
+func (s *Server) listFollow(ctx context.Context, userID int64) ([]*pb.Follow, error) {
+	key := "follows:" + strconv.FormatInt(userID, 10)
+	if v, ok := s.cache.Get(key); ok {
+		return v.([]*pb.Follow), nil
+	}
+
+	res, err := s.followClient.ListFollow(ctx, &pb.RequestListFollow{
+		Follower:   userID,
+		FollowType: pb.FollowListType_FOLLOWER,
+	})
 	if err != nil {
-		return status.Errorf(codes.Internal, "Could not list timeline: %v", err)
+		return nil, err
 	}
 
+	s.cache.Set(key, res.Follows, cache.UserTag(userID))
+	return res.Follows, nil
+}
+
+// CacheStats return the follow lists and timeline pages cache statistics.
+func (s *Server) CacheStats() cache.Stats {
+	return s.cache.Stats()
+}
+
+// Close stop the timeline worker and the event store.
+func (s *Server) Close() error {
+	close(s.done)
+
+	if s.eventStore != nil {
+		return s.eventStore.Close()
+	}
 	return nil
 }
@@ -1,7 +1,10 @@
 package timeline_test
 
 import (
+	"bytes"
 	"context"
+	"image"
+	"image/png"
 	"io"
 	"log"
 	"math/rand"
@@ -9,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -22,6 +26,9 @@ import (
 	"github.com/idirall22/twee/timeline"
 	tlpostgresstore "github.com/idirall22/twee/timeline/store/postgres"
 	"github.com/idirall22/twee/tweet"
+	memoryeventstore "github.com/idirall22/twee/tweet/event_store/memory"
+	memoryblobstore "github.com/idirall22/twee/tweet/media/memory"
+	memorysearch "github.com/idirall22/twee/tweet/search/memory"
 	postgresstore "github.com/idirall22/twee/tweet/store/postgres"
 )
 
@@ -40,8 +47,10 @@ func TestTimeline(t *testing.T) {
 	authAddr := startAuthTestServer(t, jwtManager)
 	authClient := startAuthClient(t, authAddr)
 
+	serviceManager := auth.NewServiceTokenManager("service-secret", time.Minute*5)
+
 	// starting follow server
-	fAddr := startFollowTestServer(t, jwtManager)
+	fAddr := startFollowTestServer(t, jwtManager, serviceManager)
 	followClient := startFollowClient(t, fAddr)
 
 	// starting tweet server
@@ -49,7 +58,10 @@ func TestTimeline(t *testing.T) {
 	tweetClient := startTweetClient(t, tAddr)
 
 	// starting timeline server
-	tmAddr := startTimelineTestServer(t, jwtManager, followClient)
+	serviceFollowClient := startFollowClient(t, fAddr, grpc.WithPerRPCCredentials(
+		auth.NewServiceCredentials(serviceManager, "timeline", follow.ServiceName),
+	))
+	tmAddr := startTimelineTestServer(t, jwtManager, serviceFollowClient)
 	timelineClient := startTimelineClient(t, tmAddr)
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
@@ -121,8 +133,120 @@ func TestTimeline(t *testing.T) {
 			log.Fatalf("Error to fetch user timeline: %v", err)
 		}
 		require.NotNil(t, resTimeline)
+		if resTimeline.Tweet == nil {
+			continue
+		}
 		log.Println(resTimeline.Tweet.Id, resTimeline.Tweet.UserId)
 	}
+
+	// page through the owner timeline one tweet at a time, newest first.
+	pageToken := ""
+	ids := []int64{}
+	for {
+		stream, err := timelineClient.Timeline(uctx, &pb.TimelineRequest{
+			Type:      pb.TimelineType_OWNER,
+			UserId:    1,
+			PageSize:  1,
+			PageToken: pageToken,
+		})
+		require.NoError(t, err)
+
+		pageToken = ""
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			if res.Tweet != nil {
+				ids = append(ids, res.Tweet.Id)
+			}
+			if res.NextPageToken != "" {
+				pageToken = res.NextPageToken
+			}
+		}
+		if pageToken == "" {
+			break
+		}
+	}
+	require.Len(t, ids, 2)
+	require.Greater(t, ids[0], ids[1])
+
+	// ranked tweets come with the reason they are shown.
+	rankedStream, err := timelineClient.Timeline(uctx, &pb.TimelineRequest{Type: pb.TimelineType_RANKED})
+	require.NoError(t, err)
+
+	ranked := 0
+	for {
+		res, err := rankedStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.NotEqual(t, pb.RankReason_REASON_UNSPECIFIED, res.Reason)
+		ranked++
+	}
+	require.NotZero(t, ranked)
+
+	// user 2 tweets an image and a poll, user 1 home timeline shows them
+	// like the tweet service does.
+	uctx2 := metadata.AppendToOutgoingContext(context.Background(), auth.AuthKey, accessTokens[1])
+
+	img := &bytes.Buffer{}
+	require.NoError(t, png.Encode(img, image.NewRGBA(image.Rect(0, 0, 3, 2))))
+	uploadStream, err := tweetClient.UploadMedia(uctx2)
+	require.NoError(t, err)
+	require.NoError(t, uploadStream.Send(&pb.UploadMediaRequest{Chunk: img.Bytes()}))
+	resUpload, err := uploadStream.CloseAndRecv()
+	require.NoError(t, err)
+
+	resMedia, err := tweetClient.Create(uctx2, &pb.CreateTweetRequest{MediaIds: []int64{resUpload.Media.Id}})
+	require.NoError(t, err)
+
+	closesAt, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	resPoll, err := tweetClient.Create(uctx2, &pb.CreateTweetRequest{
+		Content: "tabs or spaces?",
+		Poll:    &pb.NewPoll{Options: []string{"tabs", "spaces"}, ClosesAt: closesAt},
+	})
+	require.NoError(t, err)
+
+	// user 1 likes the image tweet and user 3 retweets it.
+	_, err = tweetClient.Like(uctx, &pb.LikeRequest{TweetId: resMedia.Id})
+	require.NoError(t, err)
+
+	uctx3 := metadata.AppendToOutgoingContext(context.Background(), auth.AuthKey, accessTokens[2])
+	resRetweet, err := tweetClient.Retweet(uctx3, &pb.RetweetRequest{TweetId: resMedia.Id})
+	require.NoError(t, err)
+
+	homeStream, err := timelineClient.Timeline(uctx, &pb.TimelineRequest{Type: pb.TimelineType_HOME})
+	require.NoError(t, err)
+
+	home := map[int64]*pb.Tweet{}
+	for {
+		res, err := homeStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if res.Tweet != nil {
+			home[res.Tweet.Id] = res.Tweet
+		}
+	}
+	require.Contains(t, home, resMedia.Id)
+	require.Len(t, home[resMedia.Id].Media, 1)
+	require.Equal(t, resUpload.Media.Sha256, home[resMedia.Id].Media[0].Sha256)
+
+	// user 1 did not vote, the results are hidden.
+	require.Contains(t, home, resPoll.Id)
+	require.Len(t, home[resPoll.Id].Poll.Options, 2)
+	require.False(t, home[resPoll.Id].Poll.ResultsVisible)
+
+	// the retweet shows the like of its original.
+	require.Contains(t, home, resRetweet.Id)
+	require.True(t, home[resRetweet.Id].Liked)
+	require.Equal(t, uint32(1), home[resRetweet.Id].LikeCount)
+	require.True(t, home[resRetweet.Id].Original.Liked)
 }
 
 // start auth server
@@ -164,7 +288,11 @@ func startTimelineClient(t *testing.T, address string) pb.TimelineServiceClient
 }
 
 // start auth server
-func startFollowTestServer(t *testing.T, jwtManager *auth.JwtManager) string {
+func startFollowTestServer(
+	t *testing.T,
+	jwtManager *auth.JwtManager,
+	serviceManager *auth.ServiceTokenManager,
+) string {
 	pStore, err := fpostgresstore.NewPostgresFollowStore(common.PostgresTestOptions)
 	require.NoError(t, err)
 	require.NotNil(t, pStore)
@@ -173,7 +301,8 @@ func startFollowTestServer(t *testing.T, jwtManager *auth.JwtManager) string {
 	require.NoError(t, err)
 	require.NotNil(t, server)
 
-	jwtInterceptor := auth.NewJwtInterceptor(jwtManager)
+	jwtInterceptor := auth.NewJwtInterceptor(jwtManager).
+		AllowServices(serviceManager, follow.ServiceName, follow.ServiceMethods...)
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(jwtInterceptor.Unary()),
 		grpc.StreamInterceptor(jwtInterceptor.Stream()),
@@ -189,8 +318,8 @@ func startFollowTestServer(t *testing.T, jwtManager *auth.JwtManager) string {
 }
 
 // start auth client
-func startFollowClient(t *testing.T, address string) pb.FollowServiceClient {
-	conn, err := grpc.Dial(address, grpc.WithInsecure())
+func startFollowClient(t *testing.T, address string, opts ...grpc.DialOption) pb.FollowServiceClient {
+	conn, err := grpc.Dial(address, append(opts, grpc.WithInsecure())...)
 	require.NoError(t, err)
 	require.NotNil(t, conn)
 	return pb.NewFollowServiceClient(conn)
@@ -228,12 +357,15 @@ func startTweetTestServer(t *testing.T, jwtManager *auth.JwtManager) string {
 	require.NoError(t, err)
 	require.NotNil(t, pStore)
 
-	server, err := tweet.NewTweetServer(pStore, nil)
+	server, err := tweet.NewTweetServer(pStore, memoryeventstore.NewMemoryEventStore(), memorysearch.NewMemorySearcher(), memoryblobstore.NewMemoryBlobStore())
 	require.NoError(t, err)
 	require.NotNil(t, server)
 
 	jwtInterceptor := auth.NewJwtInterceptor(jwtManager)
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(jwtInterceptor.Unary()))
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(jwtInterceptor.Unary()),
+		grpc.StreamInterceptor(jwtInterceptor.Stream()),
+	)
 	pb.RegisterTweetServiceServer(grpcServer, server)
 
 	listner, err := net.Listen("tcp", ":0")
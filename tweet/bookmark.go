@@ -0,0 +1,178 @@
+package tweet
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
+)
+
+var (
+	// defaultBookmarksLimit number of bookmarks listed when no limit is given.
+	defaultBookmarksLimit int32 = 20
+
+	// maxBookmarksLimit maximum number of bookmarks listed at once.
+	maxBookmarksLimit int32 = 100
+
+	// maxBookmarkFolderNameLength maximum number of characters of a folder name.
+	maxBookmarkFolderNameLength = 50
+)
+
+// Bookmark a tweet, bookmarking a retweet bookmarks its original. Bookmarks
+// are private, no event is published.
+func (s *Server) Bookmark(ctx context.Context, req *pb.BookmarkRequest) (*pb.BookmarkResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	tweet, err := s.getOriginal(ctx, userInfos.ID, req.GetTweetId())
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get tweet: %v", err)
+	}
+
+	err = s.tweetStore.Bookmark(ctx, userInfos.ID, tweet.Id, req.GetFolderId())
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Bookmark folder not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not bookmark tweet: %v", err)
+	}
+
+	return &pb.BookmarkResponse{}, nil
+}
+
+// Unbookmark remove a bookmark.
+func (s *Server) Unbookmark(ctx context.Context, req *pb.UnbookmarkRequest) (*pb.UnbookmarkResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.tweetStore.Unbookmark(ctx, userInfos.ID, req.GetTweetId())
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Bookmark not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not remove bookmark: %v", err)
+	}
+
+	return &pb.UnbookmarkResponse{}, nil
+}
+
+// ListBookmarks list the user bookmarked tweets.
+func (s *Server) ListBookmarks(req *pb.ListBookmarksRequest, stream pb.TweetService_ListBookmarksServer) error {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultBookmarksLimit
+	}
+	if limit > maxBookmarksLimit {
+		limit = maxBookmarksLimit
+	}
+
+	offset := req.GetOffset()
+	if offset < 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid offset")
+	}
+
+	tweets, err := s.tweetStore.ListBookmarks(stream.Context(), userInfos.ID, req.GetFolderId(), limit, offset)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not list bookmarks: %v", err)
+	}
+
+	for _, tweet := range tweets {
+		err := stream.Send(&pb.ListBookmarksResponse{Tweet: tweet})
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not send tweet: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// CreateBookmarkFolder create a bookmark folder.
+func (s *Server) CreateBookmarkFolder(ctx context.Context, req *pb.CreateBookmarkFolderRequest) (*pb.CreateBookmarkFolderResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	name := strings.TrimSpace(req.GetName())
+	if len(name) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Bookmark folder name is empty")
+	}
+	if utf8.RuneCountInString(name) > maxBookmarkFolderNameLength {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bookmark folder name is longer than %d characters", maxBookmarkFolderNameLength)
+	}
+
+	folder, err := s.tweetStore.CreateBookmarkFolder(ctx, userInfos.ID, name)
+	if err == utils.ErrAlreadyExists {
+		return nil, status.Errorf(codes.AlreadyExists, "Bookmark folder already exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not create bookmark folder: %v", err)
+	}
+
+	return &pb.CreateBookmarkFolderResponse{Folder: folder}, nil
+}
+
+// ListBookmarkFolders list the user bookmark folders.
+func (s *Server) ListBookmarkFolders(req *pb.ListBookmarkFoldersRequest, stream pb.TweetService_ListBookmarkFoldersServer) error {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	folders, err := s.tweetStore.ListBookmarkFolders(stream.Context(), userInfos.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not list bookmark folders: %v", err)
+	}
+
+	for _, folder := range folders {
+		err := stream.Send(&pb.ListBookmarkFoldersResponse{Folder: folder})
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not send bookmark folder: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteBookmarkFolder delete a bookmark folder and its bookmarks.
+func (s *Server) DeleteBookmarkFolder(ctx context.Context, req *pb.DeleteBookmarkFolderRequest) (*pb.DeleteBookmarkFolderResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.tweetStore.DeleteBookmarkFolder(ctx, userInfos.ID, req.GetId())
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Bookmark folder not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete bookmark folder: %v", err)
+	}
+
+	return &pb.DeleteBookmarkFolderResponse{}, nil
+}
@@ -0,0 +1,92 @@
+package tweet
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
+)
+
+// GetConversation stream the conversation of a tweet as a tree, tweets are
+// sent depth first starting from the conversation root so a reply always
+// follows its parent.
+func (s *Server) GetConversation(req *pb.GetConversationRequest, stream pb.TweetService_GetConversationServer) error {
+	ctx := stream.Context()
+
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	tweet, err := s.tweetStore.Get(ctx, userInfos.ID, req.GetTweetId())
+	if err == utils.ErrNotExists {
+		return status.Errorf(codes.NotFound, "Tweet not exists")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get tweet: %v", err)
+	}
+
+	conversationID := tweet.ConversationId
+	if conversationID == 0 {
+		conversationID = tweet.Id
+	}
+
+	tweets, err := s.tweetStore.Conversation(ctx, userInfos.ID, conversationID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get conversation: %v", err)
+	}
+
+	return walkConversation(tweets, func(tweet *pb.Tweet, depth int32) error {
+		err := stream.Send(&pb.GetConversationResponse{Tweet: tweet, Depth: depth})
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not send tweet: %v", err)
+		}
+		return nil
+	})
+}
+
+// walkConversation visit conversation tweets depth first, tweets must be
+// ordered by creation date. A tweet whose parent is not part of the
+// conversation anymore is visited as a root.
+func walkConversation(tweets []*pb.Tweet, visit func(tweet *pb.Tweet, depth int32) error) error {
+	ids := make(map[int64]bool, len(tweets))
+	for _, t := range tweets {
+		ids[t.Id] = true
+	}
+
+	roots := []*pb.Tweet{}
+	replies := make(map[int64][]*pb.Tweet)
+	for _, t := range tweets {
+		if t.InReplyToTweetId == 0 || !ids[t.InReplyToTweetId] {
+			roots = append(roots, t)
+			continue
+		}
+		replies[t.InReplyToTweetId] = append(replies[t.InReplyToTweetId], t)
+	}
+
+	var walk func(t *pb.Tweet, depth int32) error
+	walk = func(t *pb.Tweet, depth int32) error {
+		err := visit(t, depth)
+		if err != nil {
+			return err
+		}
+		for _, r := range replies[t.Id] {
+			err = walk(r, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, r := range roots {
+		err := walk(r, 0)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
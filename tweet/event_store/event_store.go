@@ -12,6 +12,8 @@ type EventStore interface {
 	Start() error
 	// Publish to event store
 	Publish(ctx context.Context, n *pb.TweetEvent) error
+	// Subscribe to published events.
+	Subscribe() (<-chan *pb.TweetEvent, error)
 	// Close event store connection.
 	Close() error
 }
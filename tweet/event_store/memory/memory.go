@@ -0,0 +1,61 @@
+package memoryeventstore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/idirall22/twee/pb"
+)
+
+// MemoryEventStore deliver tweet events to the subscribers of the same
+// process, it is meant for tests and single instance setups.
+type MemoryEventStore struct {
+	mu          sync.Mutex
+	subscribers []chan *pb.TweetEvent
+	done        chan struct{}
+}
+
+// NewMemoryEventStore create new in memory event store.
+func NewMemoryEventStore() *MemoryEventStore {
+	return &MemoryEventStore{done: make(chan struct{})}
+}
+
+// Start event store, it waits for the store to be closed.
+func (e *MemoryEventStore) Start() error {
+	<-e.done
+	return nil
+}
+
+// Publish an event to every subscriber.
+func (e *MemoryEventStore) Publish(ctx context.Context, te *pb.TweetEvent) error {
+	e.mu.Lock()
+	subscribers := e.subscribers
+	e.mu.Unlock()
+
+	for _, s := range subscribers {
+		select {
+		case s <- te:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Subscribe to published events, only events published after the call
+// are received.
+func (e *MemoryEventStore) Subscribe() (<-chan *pb.TweetEvent, error) {
+	events := make(chan *pb.TweetEvent, 128)
+
+	e.mu.Lock()
+	e.subscribers = append(e.subscribers, events)
+	e.mu.Unlock()
+
+	return events, nil
+}
+
+// Close event store.
+func (e *MemoryEventStore) Close() error {
+	close(e.done)
+	return nil
+}
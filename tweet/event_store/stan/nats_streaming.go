@@ -3,6 +3,7 @@ package teventstore
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/idirall22/twee/common"
 	"github.com/idirall22/twee/pb"
@@ -11,9 +12,9 @@ import (
 
 // NatsStreamingEventStore event store.
 type NatsStreamingEventStore struct {
-	cc          stan.Conn
-	subject     string
-	tweetEvents chan *pb.TweetEvent
+	cc      stan.Conn
+	subject string
+	done    chan struct{}
 }
 
 // NewNatsStreamingEventStore create new stan event store.
@@ -24,36 +25,61 @@ func NewNatsStreamingEventStore(subject, clusterID, clientID string, option ...s
 	}
 
 	return &NatsStreamingEventStore{
-		cc:          sc,
-		subject:     subject,
-		tweetEvents: make(chan *pb.TweetEvent, 128),
+		cc:      sc,
+		subject: subject,
+		done:    make(chan struct{}),
 	}, nil
 }
 
-// Start event store.
+// Start event store, events are published synchronously so it only waits
+// for the store to be closed.
 func (e *NatsStreamingEventStore) Start() error {
-	for {
-		select {
-		case n := <-e.tweetEvents:
-			data, err := common.ProtobufToJSON(n)
-			if err != nil {
-				return fmt.Errorf("Could not serialize data to json: %v", err)
-			}
-			err = e.cc.Publish(e.subject, []byte(data))
-			if err != nil {
-				return fmt.Errorf("Could not publish to nats: %v", err)
-			}
-		}
-	}
+	<-e.done
+	return nil
 }
 
-// Publish to event store
+// Publish to event store, it return once the event is acknowledged by
+// nats so a failed event can be published again.
 func (e *NatsStreamingEventStore) Publish(ctx context.Context, te *pb.TweetEvent) error {
-	e.tweetEvents <- te
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := common.ProtobufToJSON(te)
+	if err != nil {
+		return fmt.Errorf("Could not serialize data to json: %v", err)
+	}
+
+	err = e.cc.Publish(e.subject, []byte(data))
+	if err != nil {
+		return fmt.Errorf("Could not publish to nats: %v", err)
+	}
 	return nil
 }
 
+// Subscribe to published events, only events published after the call
+// are received.
+func (e *NatsStreamingEventStore) Subscribe() (<-chan *pb.TweetEvent, error) {
+	events := make(chan *pb.TweetEvent, 128)
+
+	_, err := e.cc.Subscribe(e.subject, func(msg *stan.Msg) {
+		te := &pb.TweetEvent{}
+		err := common.JSONToProtobufMessage(string(msg.Data), te)
+		if err != nil {
+			log.Printf("Could not parse tweet event: %v", err)
+			return
+		}
+		events <- te
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not subscribe to nats: %v", err)
+	}
+
+	return events, nil
+}
+
 // Close event store connection.
 func (e *NatsStreamingEventStore) Close() error {
+	close(e.done)
 	return e.cc.Close()
 }
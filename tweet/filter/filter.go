@@ -0,0 +1,235 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/search"
+)
+
+// Rules tweets matching any rule are sent to a subscriber.
+type Rules struct {
+	// Keywords words or phrases, every word must appear in the tweet.
+	Keywords []string
+	// Hashtags without #.
+	Hashtags []string
+	// AuthorIDs authors of the tweets.
+	AuthorIDs []int64
+}
+
+// Count return the number of rules.
+func (r *Rules) Count() int {
+	return len(r.Keywords) + len(r.Hashtags) + len(r.AuthorIDs)
+}
+
+// Match a tweet matched by a subscriber rules.
+type Match struct {
+	Tweet *pb.Tweet
+	// Rules matched rules formatted as keyword:..., hashtag:... or author:...
+	Rules []string
+}
+
+// Subscriber receive tweets matching its rules on C.
+type Subscriber struct {
+	id       int64
+	rules    Rules
+	keywords []keyword
+	// C matched tweets, tweets are dropped when the subscriber is too slow.
+	C chan *Match
+}
+
+// keyword a tokenized keyword rule.
+type keyword struct {
+	rule   string
+	tokens []string
+}
+
+// set subscribers ids.
+type set map[int64]struct{}
+
+// Hub dispatch tweets to subscribers, rules are indexed by keyword first
+// token, hashtag and author so a tweet is only checked against subscribers
+// that may match it.
+type Hub struct {
+	mu          sync.RWMutex
+	nextID      int64
+	buffer      int
+	subscribers map[int64]*Subscriber
+	// rules subscribers by rule key, see keywordKey, hashtagKey and authorKey.
+	rules map[string]set
+}
+
+// NewHub create new hub, buffer is the size of subscribers channel.
+func NewHub(buffer int) *Hub {
+	return &Hub{
+		buffer:      buffer,
+		subscribers: map[int64]*Subscriber{},
+		rules:       map[string]set{},
+	}
+}
+
+// Subscribe register a subscriber.
+func (h *Hub) Subscribe(rules Rules) (*Subscriber, error) {
+	sub := &Subscriber{C: make(chan *Match, h.buffer)}
+
+	for _, k := range rules.Keywords {
+		tokens := search.Tokenize(k)
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("Invalid keyword %q", k)
+		}
+		sub.keywords = append(sub.keywords, keyword{rule: strings.Join(tokens, " "), tokens: tokens})
+		sub.rules.Keywords = append(sub.rules.Keywords, strings.Join(tokens, " "))
+	}
+
+	for _, tag := range rules.Hashtags {
+		tag = strings.ToLower(strings.TrimPrefix(tag, "#"))
+		if len(tag) == 0 {
+			return nil, fmt.Errorf("Invalid empty hashtag")
+		}
+		sub.rules.Hashtags = append(sub.rules.Hashtags, tag)
+	}
+
+	sub.rules.AuthorIDs = append(sub.rules.AuthorIDs, rules.AuthorIDs...)
+
+	if sub.rules.Count() == 0 {
+		return nil, fmt.Errorf("No rules")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	sub.id = h.nextID
+	h.subscribers[sub.id] = sub
+
+	for _, k := range sub.keywords {
+		h.index(keywordKey(k.tokens[0]), sub.id)
+	}
+	for _, tag := range sub.rules.Hashtags {
+		h.index(hashtagKey(tag), sub.id)
+	}
+	for _, id := range sub.rules.AuthorIDs {
+		h.index(authorKey(id), sub.id)
+	}
+
+	return sub, nil
+}
+
+// Unsubscribe remove a subscriber.
+func (h *Hub) Unsubscribe(sub *Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscribers[sub.id]; !ok {
+		return
+	}
+	delete(h.subscribers, sub.id)
+
+	for _, k := range sub.keywords {
+		h.unindex(keywordKey(k.tokens[0]), sub.id)
+	}
+	for _, tag := range sub.rules.Hashtags {
+		h.unindex(hashtagKey(tag), sub.id)
+	}
+	for _, id := range sub.rules.AuthorIDs {
+		h.unindex(authorKey(id), sub.id)
+	}
+}
+
+// Len return the number of subscribers.
+func (h *Hub) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subscribers)
+}
+
+// Publish send a tweet to the subscribers matching it, it return the
+// number of subscribers that received the tweet.
+func (h *Hub) Publish(tweet *pb.Tweet) int {
+	tokens := search.Tokenize(tweet.Content)
+	words := make(map[string]bool, len(tokens))
+	for _, t := range tokens {
+		words[t] = true
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	matches := map[int64][]string{}
+
+	for word := range words {
+		for id := range h.rules[keywordKey(word)] {
+			for _, k := range h.subscribers[id].keywords {
+				if k.tokens[0] == word && containsAll(words, k.tokens[1:]) {
+					matches[id] = append(matches[id], keywordKey(k.rule))
+				}
+			}
+		}
+	}
+
+	seenTags := map[string]bool{}
+	for _, e := range tweet.Entities {
+		if e.Type != pb.EntityType_ENTITY_HASHTAG {
+			continue
+		}
+		tag := strings.ToLower(e.Text)
+		if seenTags[tag] {
+			continue
+		}
+		seenTags[tag] = true
+		for id := range h.rules[hashtagKey(tag)] {
+			matches[id] = append(matches[id], hashtagKey(tag))
+		}
+	}
+
+	if authorID, err := strconv.ParseInt(tweet.UserId, 10, 64); err == nil {
+		key := authorKey(authorID)
+		for id := range h.rules[key] {
+			matches[id] = append(matches[id], key)
+		}
+	}
+
+	sent := 0
+	for id, rules := range matches {
+		select {
+		case h.subscribers[id].C <- &Match{Tweet: tweet, Rules: rules}:
+			sent++
+		default:
+		}
+	}
+	return sent
+}
+
+func containsAll(words map[string]bool, tokens []string) bool {
+	for _, t := range tokens {
+		if !words[t] {
+			return false
+		}
+	}
+	return true
+}
+
+// index add a subscriber to the index key.
+func (h *Hub) index(key string, id int64) {
+	if h.rules[key] == nil {
+		h.rules[key] = set{}
+	}
+	h.rules[key][id] = struct{}{}
+}
+
+// unindex remove a subscriber from the index key.
+func (h *Hub) unindex(key string, id int64) {
+	delete(h.rules[key], id)
+	if len(h.rules[key]) == 0 {
+		delete(h.rules, key)
+	}
+}
+
+func keywordKey(token string) string { return "keyword:" + token }
+
+func hashtagKey(tag string) string { return "hashtag:" + tag }
+
+func authorKey(id int64) string { return "author:" + strconv.FormatInt(id, 10) }
@@ -0,0 +1,49 @@
+package filter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/entity"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/filter"
+)
+
+func TestHub(t *testing.T) {
+	hub := filter.NewHub(8)
+
+	golang, err := hub.Subscribe(filter.Rules{Keywords: []string{"Go fast"}, Hashtags: []string{"#golang"}})
+	require.NoError(t, err)
+
+	author, err := hub.Subscribe(filter.Rules{AuthorIDs: []int64{2}})
+	require.NoError(t, err)
+
+	_, err = hub.Subscribe(filter.Rules{})
+	require.Error(t, err)
+
+	newTweet := func(userID int, content string) *pb.Tweet {
+		return &pb.Tweet{UserId: fmt.Sprint(userID), Content: content, Entities: entity.Parse(content)}
+	}
+
+	require.Equal(t, 2, hub.Publish(newTweet(2, "go is fast")))
+	require.Equal(t, []string{"keyword:go fast"}, (<-golang.C).Rules)
+	require.Equal(t, []string{"author:2"}, (<-author.C).Rules)
+
+	require.Equal(t, 1, hub.Publish(newTweet(1, "I like #GoLang")))
+	require.Equal(t, []string{"hashtag:golang"}, (<-golang.C).Rules)
+
+	// every keyword word must appear.
+	require.Equal(t, 0, hub.Publish(newTweet(1, "go home")))
+
+	hub.Unsubscribe(golang)
+	require.Equal(t, 1, hub.Len())
+	require.Equal(t, 0, hub.Publish(newTweet(1, "go is fast #golang")))
+
+	// slow subscribers miss tweets instead of blocking the hub.
+	for i := 0; i < 10; i++ {
+		hub.Publish(newTweet(2, "hello"))
+	}
+	require.Len(t, author.C, 8)
+}
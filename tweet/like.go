@@ -0,0 +1,127 @@
+package tweet
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
+)
+
+var (
+	// defaultLikersLimit number of likers listed when no limit is given.
+	defaultLikersLimit int32 = 20
+
+	// maxLikersLimit maximum number of likers listed at once.
+	maxLikersLimit int32 = 100
+)
+
+// Like a tweet, liking a retweet likes its original.
+func (s *Server) Like(ctx context.Context, req *pb.LikeRequest) (*pb.LikeResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	tweet, err := s.getOriginal(ctx, userInfos.ID, req.GetTweetId())
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get tweet: %v", err)
+	}
+
+	tweetUserID, err := strconv.ParseInt(tweet.UserId, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Invalid tweet user id: %v", err)
+	}
+
+	e := &pb.TweetEvent{
+		Action:      pb.Action_CREATED,
+		Type:        pb.Type_LIKE,
+		Title:       fmt.Sprintf("%s liked your tweet", userInfos.Username),
+		TweetId:     tweet.Id,
+		UserId:      userInfos.ID,
+		TweetUserId: tweetUserID,
+	}
+
+	err = s.tweetStore.Like(ctx, userInfos.ID, tweet.Id, e)
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
+	if err == utils.ErrAlreadyExists {
+		return nil, status.Errorf(codes.AlreadyExists, "Tweet already liked")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not like tweet: %v", err)
+	}
+
+	return &pb.LikeResponse{}, nil
+}
+
+// Unlike a tweet.
+func (s *Server) Unlike(ctx context.Context, req *pb.UnlikeRequest) (*pb.UnlikeResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.tweetStore.Unlike(ctx, userInfos.ID, req.GetTweetId())
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Like not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not unlike tweet: %v", err)
+	}
+
+	return &pb.UnlikeResponse{}, nil
+}
+
+// ListLikers list users who liked a tweet.
+func (s *Server) ListLikers(req *pb.ListLikersRequest, stream pb.TweetService_ListLikersServer) error {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultLikersLimit
+	}
+	if limit > maxLikersLimit {
+		limit = maxLikersLimit
+	}
+
+	offset := req.GetOffset()
+	if offset < 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid offset")
+	}
+
+	// anonymous viewers only see the likers of public tweets.
+	var viewerID int64
+	if userInfos, err := auth.GetUserInfosFromContext(stream.Context()); err == nil {
+		viewerID = userInfos.ID
+	}
+
+	err := s.tweetStore.ListLikers(
+		stream.Context(),
+		viewerID,
+		req.GetTweetId(),
+		limit,
+		offset,
+		func(user *pb.User) error {
+			err := stream.Send(&pb.ListLikersResponse{User: user})
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not send user: %v", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not list likers: %v", err)
+	}
+
+	return nil
+}
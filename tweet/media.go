@@ -0,0 +1,130 @@
+package tweet
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/media"
+)
+
+// maxTweetMedia maximum number of media attached to a tweet.
+const maxTweetMedia = 4
+
+// UploadMedia upload an image or a video sent in chunks, the type is
+// checked using the content first bytes.
+func (s *Server) UploadMedia(stream pb.TweetService_UploadMediaServer) error {
+	ctx := stream.Context()
+
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	data := &bytes.Buffer{}
+	m := &pb.Media{}
+	// the type limit is known once the header is received.
+	limit := media.MaxVideoSize
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return status.Errorf(codes.Unknown, "Could not receive chunk: %v", err)
+		}
+
+		if data.Len()+len(req.GetChunk()) > limit {
+			return status.Errorf(codes.ResourceExhausted, "Media is too large, maximum size is %d bytes", limit)
+		}
+		data.Write(req.GetChunk())
+
+		if len(m.ContentType) == 0 && data.Len() >= media.HeaderSize {
+			err = detectMedia(m, data.Bytes())
+			if err != nil {
+				return err
+			}
+
+			limit = media.MaxSize(m.Type)
+			if data.Len() > limit {
+				return status.Errorf(codes.ResourceExhausted, "Media is too large, maximum size is %d bytes", limit)
+			}
+		}
+	}
+
+	// contents shorter than the header.
+	if len(m.ContentType) == 0 {
+		err = detectMedia(m, data.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	sum := sha256.Sum256(data.Bytes())
+	m.Sha256 = hex.EncodeToString(sum[:])
+	m.Size = int64(data.Len())
+	m.Width, m.Height = media.Dimensions(m.ContentType, data.Bytes())
+
+	// blobs are keyed by content so identical uploads share a blob.
+	err = s.blobStore.Put(ctx, m.Sha256, bytes.NewReader(data.Bytes()))
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not store media: %v", err)
+	}
+
+	m.Id, err = s.tweetStore.CreateMedia(ctx, userInfos.ID, m)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not save media: %v", err)
+	}
+	m.CreatedAt = ptypes.TimestampNow()
+
+	return stream.SendAndClose(&pb.UploadMediaResponse{Media: m})
+}
+
+// attachedMedia return the media attached to a tweet, errors are grpc status.
+func (s *Server) attachedMedia(ctx context.Context, userID int64, ids []int64) ([]*pb.Media, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if len(ids) > maxTweetMedia {
+		return nil, status.Errorf(codes.InvalidArgument, "A tweet can have at most %d media", maxTweetMedia)
+	}
+
+	seen := map[int64]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			return nil, status.Errorf(codes.InvalidArgument, "Media %d is attached twice", id)
+		}
+		seen[id] = true
+	}
+
+	found, err := s.tweetStore.FindMedia(ctx, userID, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get media: %v", err)
+	}
+	if len(found) != len(ids) {
+		return nil, status.Errorf(codes.NotFound, "Media not exists")
+	}
+	return found, nil
+}
+
+// detectMedia set the media type from the content header.
+func detectMedia(m *pb.Media, header []byte) error {
+	contentType, mediaType, ok := media.Detect(header)
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "Unsupported media type")
+	}
+	m.ContentType = contentType
+	m.Type = mediaType
+	return nil
+}
@@ -0,0 +1,99 @@
+package fsblobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/idirall22/twee/tweet/media"
+)
+
+// FileSystemBlobStore store blobs as files in a directory.
+type FileSystemBlobStore struct {
+	dir string
+}
+
+// NewFileSystemBlobStore create new file system blob store, the directory
+// is created when it does not exist.
+func NewFileSystemBlobStore(dir string) (*FileSystemBlobStore, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("Could not create blob directory: %v", err)
+	}
+	return &FileSystemBlobStore{dir: dir}, nil
+}
+
+// Put write a blob to a temporary file renamed once complete so readers
+// never see partial blobs.
+func (f *FileSystemBlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(f.dir, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("Could not create blob file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("Could not write blob: %v", err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return fmt.Errorf("Could not write blob: %v", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("Could not save blob: %v", err)
+	}
+	return nil
+}
+
+// Get open a blob file.
+func (f *FileSystemBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := f.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, media.ErrBlobNotExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not open blob: %v", err)
+	}
+	return file, nil
+}
+
+// Delete a blob file.
+func (f *FileSystemBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("Could not delete blob: %v", err)
+	}
+	return nil
+}
+
+// path return the file of a key, keys can not leave the directory.
+func (f *FileSystemBlobStore) path(key string) (string, error) {
+	if len(key) == 0 || strings.ContainsAny(key, `/\`) || strings.HasPrefix(key, ".") {
+		return "", fmt.Errorf("Invalid blob key %q", key)
+	}
+	return filepath.Join(f.dir, key), nil
+}
@@ -0,0 +1,166 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"image"
+	// image decoders used to read dimensions
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+
+	"github.com/idirall22/twee/pb"
+)
+
+// BlobStore store media contents by key.
+type BlobStore interface {
+	// Put store a blob, an existing blob with the same key is replaced.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get open a blob, it return ErrBlobNotExists when not found.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete a blob.
+	Delete(ctx context.Context, key string) error
+}
+
+// ErrBlobNotExists blob not found.
+var ErrBlobNotExists = fmt.Errorf("Blob not exists")
+
+const (
+	// MaxImageSize maximum size of jpeg, png and webp images.
+	MaxImageSize = 5 << 20
+	// MaxGIFSize maximum size of gif images.
+	MaxGIFSize = 15 << 20
+	// MaxVideoSize maximum size of videos.
+	MaxVideoSize = 64 << 20
+)
+
+// MaxSize return the maximum size of a media type.
+func MaxSize(t pb.MediaType) int {
+	switch t {
+	case pb.MediaType_MEDIA_GIF:
+		return MaxGIFSize
+	case pb.MediaType_MEDIA_VIDEO:
+		return MaxVideoSize
+	default:
+		return MaxImageSize
+	}
+}
+
+// HeaderSize number of bytes needed by Detect.
+const HeaderSize = 12
+
+// signature magic bytes of a content type, zero bytes of mask are ignored.
+type signature struct {
+	contentType string
+	mediaType   pb.MediaType
+	magic       []byte
+	mask        []byte
+}
+
+var signatures = []signature{
+	{"image/jpeg", pb.MediaType_MEDIA_IMAGE, []byte{0xFF, 0xD8, 0xFF}, nil},
+	{"image/png", pb.MediaType_MEDIA_IMAGE, []byte("\x89PNG\r\n\x1a\n"), nil},
+	{"image/gif", pb.MediaType_MEDIA_GIF, []byte("GIF87a"), nil},
+	{"image/gif", pb.MediaType_MEDIA_GIF, []byte("GIF89a"), nil},
+	{"image/webp", pb.MediaType_MEDIA_IMAGE, []byte("RIFF\x00\x00\x00\x00WEBP"), []byte("\xFF\xFF\xFF\xFF\x00\x00\x00\x00\xFF\xFF\xFF\xFF")},
+	{"video/mp4", pb.MediaType_MEDIA_VIDEO, []byte("\x00\x00\x00\x00ftyp"), []byte("\x00\x00\x00\x00\xFF\xFF\xFF\xFF")},
+	{"video/webm", pb.MediaType_MEDIA_VIDEO, []byte{0x1A, 0x45, 0xDF, 0xA3}, nil},
+}
+
+// Detect return the content type and media type of a content using its
+// first bytes, ok is false for unsupported contents.
+func Detect(header []byte) (contentType string, mediaType pb.MediaType, ok bool) {
+	for _, sig := range signatures {
+		if matchSignature(header, sig) {
+			return sig.contentType, sig.mediaType, true
+		}
+	}
+	return "", pb.MediaType_UNKNOWNE_MEDIA, false
+}
+
+func matchSignature(header []byte, sig signature) bool {
+	if len(header) < len(sig.magic) {
+		return false
+	}
+	for i, b := range sig.magic {
+		mask := byte(0xFF)
+		if sig.mask != nil {
+			mask = sig.mask[i]
+		}
+		if header[i]&mask != b&mask {
+			return false
+		}
+	}
+	return true
+}
+
+// Dimensions return the width and height of an image or mp4 video,
+// zero when they can not be read.
+func Dimensions(contentType string, data []byte) (int32, int32) {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return 0, 0
+		}
+		return int32(cfg.Width), int32(cfg.Height)
+	case "video/mp4":
+		return mp4Dimensions(data)
+	}
+	return 0, 0
+}
+
+// mp4Dimensions read the dimensions of the first video track header,
+// boxes are walked down moov/trak/tkhd.
+func mp4Dimensions(data []byte) (int32, int32) {
+	var width, height uint32
+
+	eachBox(findBox(data, "moov"), func(name string, trak []byte) bool {
+		if name != "trak" {
+			return true
+		}
+
+		// width and height are 16.16 fixed point numbers ending the box.
+		tkhd := findBox(trak, "tkhd")
+		if len(tkhd) < 8 {
+			return true
+		}
+		width = binary.BigEndian.Uint32(tkhd[len(tkhd)-8:]) >> 16
+		height = binary.BigEndian.Uint32(tkhd[len(tkhd)-4:]) >> 16
+
+		// audio tracks have no dimensions, look at the next track.
+		return width == 0 || height == 0
+	})
+
+	return int32(width), int32(height)
+}
+
+// eachBox call f with the type and content of each box until f return false.
+func eachBox(data []byte, f func(name string, content []byte) bool) {
+	for len(data) >= 8 {
+		size := int(binary.BigEndian.Uint32(data))
+		if size < 8 || size > len(data) {
+			return
+		}
+		if !f(string(data[4:8]), data[8:size]) {
+			return
+		}
+		data = data[size:]
+	}
+}
+
+// findBox return the content of the first box of type name.
+func findBox(data []byte, name string) []byte {
+	var found []byte
+	eachBox(data, func(n string, content []byte) bool {
+		if n == name {
+			found = content
+			return false
+		}
+		return true
+	})
+	return found
+}
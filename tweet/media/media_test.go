@@ -0,0 +1,98 @@
+package media_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/media"
+	fsblobstore "github.com/idirall22/twee/tweet/media/fs"
+	memoryblobstore "github.com/idirall22/twee/tweet/media/memory"
+)
+
+func TestDetect(t *testing.T) {
+	img := &bytes.Buffer{}
+	require.NoError(t, png.Encode(img, image.NewRGBA(image.Rect(0, 0, 40, 30))))
+
+	contentType, mediaType, ok := media.Detect(img.Bytes())
+	require.True(t, ok)
+	require.Equal(t, "image/png", contentType)
+	require.Equal(t, pb.MediaType_MEDIA_IMAGE, mediaType)
+
+	width, height := media.Dimensions(contentType, img.Bytes())
+	require.Equal(t, int32(40), width)
+	require.Equal(t, int32(30), height)
+
+	video := newMP4(1280, 720)
+	contentType, mediaType, ok = media.Detect(video)
+	require.True(t, ok)
+	require.Equal(t, "video/mp4", contentType)
+	require.Equal(t, pb.MediaType_MEDIA_VIDEO, mediaType)
+
+	width, height = media.Dimensions(contentType, video)
+	require.Equal(t, int32(1280), width)
+	require.Equal(t, int32(720), height)
+
+	_, _, ok = media.Detect([]byte("#!/bin/sh\nrm -rf /"))
+	require.False(t, ok)
+}
+
+func TestBlobStores(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blobs")
+	require.NoError(t, err)
+
+	fsStore, err := fsblobstore.NewFileSystemBlobStore(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, store := range []media.BlobStore{fsStore, memoryblobstore.NewMemoryBlobStore()} {
+		require.NoError(t, store.Put(ctx, "key", bytes.NewReader([]byte("content"))))
+
+		r, err := store.Get(ctx, "key")
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, "content", string(data))
+
+		require.NoError(t, store.Delete(ctx, "key"))
+		_, err = store.Get(ctx, "key")
+		require.Equal(t, media.ErrBlobNotExists, err)
+	}
+
+	require.Error(t, fsStore.Put(ctx, "../key", bytes.NewReader(nil)))
+}
+
+// newMP4 build a minimal mp4 with an audio track then a video track.
+func newMP4(width, height uint32) []byte {
+	box := func(name string, content ...[]byte) []byte {
+		data := bytes.Join(content, nil)
+		header := make([]byte, 8)
+		binary.BigEndian.PutUint32(header, uint32(len(data)+8))
+		copy(header[4:], name)
+		return append(header, data...)
+	}
+
+	tkhd := func(width, height uint32) []byte {
+		content := make([]byte, 84)
+		binary.BigEndian.PutUint32(content[76:], width<<16)
+		binary.BigEndian.PutUint32(content[80:], height<<16)
+		return box("tkhd", content)
+	}
+
+	return append(
+		box("ftyp", []byte("isom\x00\x00\x02\x00")),
+		box("moov",
+			box("mvhd", make([]byte, 100)),
+			box("trak", tkhd(0, 0)),
+			box("trak", tkhd(width, height)),
+		)...,
+	)
+}
@@ -0,0 +1,178 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/idirall22/twee/pb"
+)
+
+const (
+	// defaultInterval delay between two checks of pending messages.
+	defaultInterval = time.Second
+	// defaultBatch maximum number of messages claimed at once.
+	defaultBatch = 100
+	// defaultLease duration during which a claimed message is not claimed
+	// again by another relay.
+	defaultLease = time.Second * 30
+	// defaultMinBackoff delay before the first retry of a failed message.
+	defaultMinBackoff = time.Second
+	// defaultMaxBackoff maximum delay between two retries.
+	defaultMaxBackoff = time.Minute * 5
+	// defaultRetention duration during which sent messages are kept.
+	defaultRetention = time.Hour * 24 * 7
+	// purgeInterval delay between two purges of the sent messages.
+	purgeInterval = time.Hour
+)
+
+// Message an event waiting in the outbox to be published.
+type Message struct {
+	ID int64
+	// AggregateID tweet the event is about, messages of an aggregate are
+	// published in order.
+	AggregateID int64
+	Event       *pb.TweetEvent
+	// Attempts number of failed publications.
+	Attempts int32
+}
+
+// Store outbox messages storage.
+type Store interface {
+	// claim pending messages for lease, only the oldest unsent message of
+	// each aggregate is returned
+	ClaimOutbox(ctx context.Context, limit int, lease time.Duration) ([]*Message, error)
+	// mark a message as sent
+	MarkOutboxSent(ctx context.Context, id int64) error
+	// release a message that could not be published until retryAt
+	MarkOutboxFailed(ctx context.Context, id int64, retryAt time.Time, reason string) error
+	// delete the messages sent before, it return the number of messages deleted
+	PurgeOutbox(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Publisher publish an event, it return once the event is accepted.
+type Publisher interface {
+	Publish(ctx context.Context, e *pb.TweetEvent) error
+}
+
+// Relay publish the outbox messages with retries.
+type Relay struct {
+	store      Store
+	publisher  Publisher
+	interval   time.Duration
+	batch      int
+	lease      time.Duration
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	retention  time.Duration
+}
+
+// NewRelay create new outbox relay.
+func NewRelay(s Store, p Publisher) *Relay {
+	return &Relay{
+		store:      s,
+		publisher:  p,
+		interval:   defaultInterval,
+		batch:      defaultBatch,
+		lease:      defaultLease,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+		retention:  defaultRetention,
+	}
+}
+
+// SetRetention set the duration during which sent messages are kept.
+func (r *Relay) SetRetention(retention time.Duration) {
+	r.retention = retention
+}
+
+// Run publish pending messages and purge the sent ones until done is
+// closed.
+func (r *Relay) Run(done <-chan struct{}) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	var purgedAt time.Time
+	for {
+		if time.Since(purgedAt) >= purgeInterval {
+			_, err := r.Purge(context.Background())
+			if err != nil {
+				log.Printf("Could not purge outbox messages: %v", err)
+			}
+			purgedAt = time.Now()
+		}
+
+		// keep relaying while messages are published, the next message
+		// of an aggregate is only claimed once the previous one is sent.
+		for {
+			n, err := r.Relay(context.Background())
+			if err != nil {
+				log.Printf("Could not relay outbox messages: %v", err)
+			}
+			if n == 0 {
+				break
+			}
+		}
+
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relay publish a batch of pending messages, it return the number of
+// messages sent.
+func (r *Relay) Relay(ctx context.Context) (int, error) {
+	messages, err := r.store.ClaimOutbox(ctx, r.batch, r.lease)
+	if err != nil {
+		return 0, err
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].ID < messages[j].ID
+	})
+
+	sent := 0
+	for _, m := range messages {
+		err := r.publisher.Publish(ctx, m.Event)
+		if err != nil {
+			retryAt := time.Now().Add(Backoff(m.Attempts, r.minBackoff, r.maxBackoff))
+			err = r.store.MarkOutboxFailed(ctx, m.ID, retryAt, err.Error())
+			if err != nil {
+				log.Printf("Could not release outbox message %d: %v", m.ID, err)
+			}
+			continue
+		}
+
+		err = r.store.MarkOutboxSent(ctx, m.ID)
+		if err != nil {
+			// the lease expires and the message is published again.
+			log.Printf("Could not mark outbox message %d as sent: %v", m.ID, err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// Purge delete the messages sent for longer than the retention, it return
+// the number of messages deleted.
+func (r *Relay) Purge(ctx context.Context) (int64, error) {
+	return r.store.PurgeOutbox(ctx, time.Now().Add(-r.retention))
+}
+
+// Backoff delay before retrying a message after its failed attempts,
+// doubled on each attempt from min up to max.
+func Backoff(attempts int32, min, max time.Duration) time.Duration {
+	d := min
+	for i := int32(0); i < attempts; i++ {
+		d *= 2
+		if d >= max {
+			return max
+		}
+	}
+	return d
+}
@@ -0,0 +1,145 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/outbox"
+)
+
+// memoryStore keep messages in memory, like the postgres store only the
+// oldest unsent message of each aggregate is claimed.
+type memoryStore struct {
+	messages []*outbox.Message
+	sent     map[int64]bool
+	sentAt   map[int64]time.Time
+	retryAt  map[int64]time.Time
+}
+
+func (m *memoryStore) ClaimOutbox(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error) {
+	claimed := []*outbox.Message{}
+	blocked := map[int64]bool{}
+	for _, msg := range m.messages {
+		if m.sent[msg.ID] || blocked[msg.AggregateID] {
+			continue
+		}
+		blocked[msg.AggregateID] = true
+		if m.retryAt[msg.ID].After(time.Now()) {
+			continue
+		}
+		claimed = append(claimed, msg)
+	}
+	return claimed, nil
+}
+
+func (m *memoryStore) MarkOutboxSent(ctx context.Context, id int64) error {
+	m.sent[id] = true
+	m.sentAt[id] = time.Now()
+	return nil
+}
+
+func (m *memoryStore) PurgeOutbox(ctx context.Context, before time.Time) (int64, error) {
+	kept := []*outbox.Message{}
+	for _, msg := range m.messages {
+		if m.sent[msg.ID] && m.sentAt[msg.ID].Before(before) {
+			continue
+		}
+		kept = append(kept, msg)
+	}
+	purged := int64(len(m.messages) - len(kept))
+	m.messages = kept
+	return purged, nil
+}
+
+func (m *memoryStore) MarkOutboxFailed(ctx context.Context, id int64, retryAt time.Time, reason string) error {
+	for _, msg := range m.messages {
+		if msg.ID == id {
+			msg.Attempts++
+		}
+	}
+	m.retryAt[id] = retryAt
+	return nil
+}
+
+// flakyPublisher fail the events of the failing tweets.
+type flakyPublisher struct {
+	failing   map[int64]bool
+	published []*pb.TweetEvent
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, e *pb.TweetEvent) error {
+	if p.failing[e.TweetId] {
+		return errors.New("nats unavailable")
+	}
+	p.published = append(p.published, e)
+	return nil
+}
+
+func TestRelay(t *testing.T) {
+	s := &memoryStore{sent: map[int64]bool{}, sentAt: map[int64]time.Time{}, retryAt: map[int64]time.Time{}}
+	actions := []pb.Action{pb.Action_CREATED, pb.Action_UPDATED, pb.Action_DELETED}
+	for _, action := range actions {
+		for _, tweetID := range []int64{1, 2} {
+			s.messages = append(s.messages, &outbox.Message{
+				ID:          int64(len(s.messages) + 1),
+				AggregateID: tweetID,
+				Event:       &pb.TweetEvent{TweetId: tweetID, Action: action},
+			})
+		}
+	}
+
+	p := &flakyPublisher{failing: map[int64]bool{2: true}}
+	relay := outbox.NewRelay(s, p)
+
+	// one message of each tweet is relayed at once.
+	for _, action := range actions {
+		n, err := relay.Relay(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		require.Equal(t, action, p.published[len(p.published)-1].Action)
+	}
+
+	// the failed message blocks its tweet until it is retried.
+	require.Equal(t, int32(1), s.messages[1].Attempts)
+	require.False(t, s.sent[4])
+	for _, e := range p.published {
+		require.Equal(t, int64(1), e.TweetId)
+	}
+
+	p.failing = map[int64]bool{}
+	s.retryAt = map[int64]time.Time{}
+	for _, action := range actions {
+		n, err := relay.Relay(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		require.Equal(t, action, p.published[len(p.published)-1].Action)
+		require.Equal(t, int64(2), p.published[len(p.published)-1].TweetId)
+	}
+
+	n, err := relay.Relay(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	// sent messages are kept for the retention.
+	purged, err := relay.Purge(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, purged)
+
+	relay.SetRetention(0)
+	purged, err = relay.Purge(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(len(actions)*2), purged)
+	require.Empty(t, s.messages)
+}
+
+func TestBackoff(t *testing.T) {
+	require.Equal(t, time.Second, outbox.Backoff(0, time.Second, time.Minute))
+	require.Equal(t, 8*time.Second, outbox.Backoff(3, time.Second, time.Minute))
+	require.Equal(t, time.Minute, outbox.Backoff(10, time.Second, time.Minute))
+	require.Equal(t, time.Minute, outbox.Backoff(1000, time.Second, time.Minute))
+}
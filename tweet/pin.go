@@ -0,0 +1,81 @@
+package tweet
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
+)
+
+// PinTweet pin one of the user tweets on its profile.
+func (s *Server) PinTweet(ctx context.Context, req *pb.PinTweetRequest) (*pb.PinTweetResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	tweet, err := s.tweetStore.Get(ctx, userInfos.ID, req.GetTweetId())
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get tweet: %v", err)
+	}
+
+	if tweet.UserId != strconv.FormatInt(userInfos.ID, 10) {
+		return nil, status.Errorf(codes.PermissionDenied, "Only the author can pin a tweet")
+	}
+	if tweet.RetweetOfId != 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Retweets can not be pinned")
+	}
+
+	// profiles caches evict the author profile on the update.
+	e := &pb.TweetEvent{
+		Action:  pb.Action_UPDATED,
+		Title:   fmt.Sprintf("%s pinned a tweet", userInfos.Username),
+		TweetId: tweet.Id,
+		UserId:  userInfos.ID,
+	}
+
+	err = s.tweetStore.Pin(ctx, userInfos.ID, tweet.Id, e)
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not pin tweet: %v", err)
+	}
+
+	return &pb.PinTweetResponse{}, nil
+}
+
+// UnpinTweet unpin the user pinned tweet.
+func (s *Server) UnpinTweet(ctx context.Context, req *pb.UnpinTweetRequest) (*pb.UnpinTweetResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	e := &pb.TweetEvent{
+		Action: pb.Action_UPDATED,
+		Title:  fmt.Sprintf("%s unpinned a tweet", userInfos.Username),
+		UserId: userInfos.ID,
+	}
+
+	err = s.tweetStore.Unpin(ctx, userInfos.ID, e)
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "No pinned tweet")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not unpin tweet: %v", err)
+	}
+
+	return &pb.UnpinTweetResponse{}, nil
+}
@@ -0,0 +1,121 @@
+package tweet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/store"
+	"github.com/idirall22/twee/utils"
+)
+
+const (
+	minPollOptions = 2
+	maxPollOptions = 4
+	// maxPollOptionLength maximum number of characters of an option.
+	maxPollOptionLength = 25
+	minPollDuration     = 5 * time.Minute
+	maxPollDuration     = 7 * 24 * time.Hour
+)
+
+// Vote in a tweet poll.
+func (s *Server) Vote(ctx context.Context, req *pb.VoteRequest) (*pb.VoteResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.tweetStore.Vote(ctx, userInfos.ID, req.GetTweetId(), req.GetOption())
+	switch err {
+	case nil:
+	case utils.ErrNotExists:
+		return nil, status.Errorf(codes.NotFound, "Poll or option not exists")
+	case utils.ErrClosed:
+		return nil, status.Errorf(codes.FailedPrecondition, "Poll is closed")
+	case utils.ErrAlreadyExists:
+		return nil, status.Errorf(codes.AlreadyExists, "Already voted")
+	default:
+		return nil, status.Errorf(codes.Internal, "Could not vote: %v", err)
+	}
+
+	tweet, err := s.tweetStore.Get(ctx, userInfos.ID, req.GetTweetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get poll: %v", err)
+	}
+
+	return &pb.VoteResponse{Poll: tweet.Poll}, nil
+}
+
+// newPoll validate a poll request and build the poll to create, errors
+// are grpc status.
+func newPoll(req *pb.NewPoll) (*pb.Poll, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	options := req.GetOptions()
+	if len(options) < minPollOptions || len(options) > maxPollOptions {
+		return nil, status.Errorf(codes.InvalidArgument, "A poll has %d to %d options", minPollOptions, maxPollOptions)
+	}
+
+	poll := &pb.Poll{}
+	seen := map[string]bool{}
+	for i, text := range options {
+		text = strings.TrimSpace(text)
+		if len(text) == 0 || utf8.RuneCountInString(text) > maxPollOptionLength {
+			return nil, status.Errorf(codes.InvalidArgument, "Poll options have 1 to %d characters", maxPollOptionLength)
+		}
+
+		key := strings.ToLower(text)
+		if seen[key] {
+			return nil, status.Errorf(codes.InvalidArgument, "Poll option %q is duplicated", text)
+		}
+		seen[key] = true
+
+		poll.Options = append(poll.Options, &pb.PollOption{Position: int32(i), Text: text})
+	}
+
+	closesAt, err := ptypes.Timestamp(req.GetClosesAt())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid poll closing time: %v", err)
+	}
+
+	duration := time.Until(closesAt)
+	if duration < minPollDuration || duration > maxPollDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "A poll lasts %v to %v", minPollDuration, maxPollDuration)
+	}
+	poll.ClosesAt = req.GetClosesAt()
+
+	return poll, nil
+}
+
+// closePolls close a batch of polls and notify their authors and voters,
+// it return the number of polls closed.
+func (s *Server) closePolls() (int, error) {
+	ctx := context.Background()
+
+	closed, err := s.tweetStore.ClosePolls(ctx, scheduleBatch, func(c *store.ClosedPoll) []*pb.TweetEvent {
+		return []*pb.TweetEvent{{
+			Action:           pb.Action_UPDATED,
+			Type:             pb.Type_POLL,
+			Title:            fmt.Sprintf("%s's poll has ended", c.Username),
+			TweetId:          c.TweetID,
+			UserId:           c.UserID,
+			TweetUserId:      c.UserID,
+			RecipientUserIds: append([]int64{c.UserID}, c.VoterIDs...),
+		}}
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(closed), nil
+}
@@ -0,0 +1,101 @@
+package tweet
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
+)
+
+// Retweet a tweet, retweeting a retweet retweets its original.
+func (s *Server) Retweet(ctx context.Context, req *pb.RetweetRequest) (*pb.RetweetResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	original, err := s.getOriginal(ctx, userInfos.ID, req.GetTweetId())
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get tweet: %v", err)
+	}
+	if original.Visibility != pb.Visibility_PUBLIC {
+		return nil, status.Errorf(codes.FailedPrecondition, "Only public tweets can be retweeted")
+	}
+
+	originalUserID, err := strconv.ParseInt(original.UserId, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Invalid tweet user id: %v", err)
+	}
+
+	// retweets feed the retweeter followers home timelines.
+	e := &pb.TweetEvent{
+		Action:      pb.Action_CREATED,
+		Type:        pb.Type_RETWEET,
+		Title:       fmt.Sprintf("%s retweeted a tweet", userInfos.Username),
+		UserId:      userInfos.ID,
+		TweetUserId: originalUserID,
+	}
+
+	id, err := s.tweetStore.Retweet(ctx, userInfos.ID, original.Id, e)
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
+	if err == utils.ErrAlreadyExists {
+		return nil, status.Errorf(codes.AlreadyExists, "Tweet already retweeted")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retweet: %v", err)
+	}
+
+	return &pb.RetweetResponse{Id: id}, nil
+}
+
+// UndoRetweet remove the user retweet of a tweet.
+func (s *Server) UndoRetweet(ctx context.Context, req *pb.UndoRetweetRequest) (*pb.UndoRetweetResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// the retweet leaves the retweeter followers home timelines.
+	e := &pb.TweetEvent{
+		Action: pb.Action_DELETED,
+		Type:   pb.Type_RETWEET,
+		Title:  fmt.Sprintf("%s undid a retweet", userInfos.Username),
+		UserId: userInfos.ID,
+	}
+
+	err = s.tweetStore.UndoRetweet(ctx, userInfos.ID, req.GetTweetId(), e)
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Retweet not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not undo retweet: %v", err)
+	}
+
+	return &pb.UndoRetweetResponse{}, nil
+}
+
+// getOriginal get a tweet, a retweet is resolved to its original.
+func (s *Server) getOriginal(ctx context.Context, userID int64, id int64) (*pb.Tweet, error) {
+	tweet, err := s.tweetStore.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if tweet.RetweetOfId == 0 {
+		return tweet, nil
+	}
+	return s.tweetStore.Get(ctx, userID, tweet.RetweetOfId)
+}
@@ -0,0 +1,225 @@
+package tweet
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/store"
+	"github.com/idirall22/twee/utils"
+)
+
+const (
+	// scheduleInterval delay between two checks of due scheduled tweets.
+	scheduleInterval = time.Second * 5
+	// scheduleBatch maximum number of scheduled tweets published at once.
+	scheduleBatch = 100
+	// scheduleTimeout maximum duration of the publication of a batch.
+	scheduleTimeout = time.Second * 30
+)
+
+// CreateScheduledTweet save a draft or schedule a tweet.
+func (s *Server) CreateScheduledTweet(ctx context.Context, req *pb.CreateScheduledTweetRequest) (*pb.CreateScheduledTweetResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = validatePublishAt(req.GetPublishAt())
+	if err != nil {
+		return nil, err
+	}
+
+	// replied and quoted tweets are checked again at publication.
+	_, _, err = s.prepareTweet(ctx, userInfos.ID, &pb.CreateTweetRequest{
+		Content:      req.GetContent(),
+		ReplyTo:      req.GetReplyTo(),
+		QuoteTweetId: req.GetQuoteTweetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := s.tweetStore.CreateScheduled(ctx, userInfos.ID, &pb.ScheduledTweet{
+		Content:      req.GetContent(),
+		ReplyTo:      req.GetReplyTo(),
+		QuoteTweetId: req.GetQuoteTweetId(),
+		PublishAt:    req.GetPublishAt(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save scheduled tweet: %v", err)
+	}
+
+	return &pb.CreateScheduledTweetResponse{ScheduledTweet: st}, nil
+}
+
+// ListScheduledTweets list the user drafts or scheduled tweets.
+func (s *Server) ListScheduledTweets(req *pb.ListScheduledTweetsRequest, stream pb.TweetService_ListScheduledTweetsServer) error {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := s.tweetStore.ListScheduled(stream.Context(), userInfos.ID, req.GetDrafts())
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not list scheduled tweets: %v", err)
+	}
+
+	for _, st := range list {
+		err := stream.Send(&pb.ListScheduledTweetsResponse{ScheduledTweet: st})
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not send scheduled tweet: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateScheduledTweet edit or reschedule a draft or a scheduled tweet.
+func (s *Server) UpdateScheduledTweet(ctx context.Context, req *pb.UpdateScheduledTweetRequest) (*pb.UpdateScheduledTweetResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = validatePublishAt(req.GetPublishAt())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetContent()) != 0 {
+		_, err = s.validateContent(req.GetContent())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	st, err := s.tweetStore.UpdateScheduled(ctx, userInfos.ID, &pb.ScheduledTweet{
+		Id:        req.GetId(),
+		Content:   req.GetContent(),
+		PublishAt: req.GetPublishAt(),
+	})
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Scheduled tweet not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not update scheduled tweet: %v", err)
+	}
+
+	return &pb.UpdateScheduledTweetResponse{ScheduledTweet: st}, nil
+}
+
+// CancelScheduledTweet delete a draft or cancel a scheduled tweet.
+func (s *Server) CancelScheduledTweet(ctx context.Context, req *pb.CancelScheduledTweetRequest) (*pb.CancelScheduledTweetResponse, error) {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.tweetStore.DeleteScheduled(ctx, userInfos.ID, req.GetId())
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Scheduled tweet not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not cancel scheduled tweet: %v", err)
+	}
+
+	return &pb.CancelScheduledTweetResponse{}, nil
+}
+
+// schedule publish due scheduled tweets and close polls until the server
+// is closed.
+func (s *Server) schedule() {
+	ticker := time.NewTicker(scheduleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+
+		// keep publishing while full batches are found.
+		for {
+			n, err := s.publishDue()
+			if err != nil {
+				log.Printf("Could not publish scheduled tweets: %v", err)
+			}
+			if n < scheduleBatch {
+				break
+			}
+		}
+
+		for {
+			n, err := s.closePolls()
+			if err != nil {
+				log.Printf("Could not close polls: %v", err)
+			}
+			if n < scheduleBatch {
+				break
+			}
+		}
+	}
+}
+
+// publishDue publish a batch of due scheduled tweets, it return the
+// number of tweets published.
+func (s *Server) publishDue() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), scheduleTimeout)
+	defer cancel()
+
+	published, err := s.tweetStore.PublishDue(ctx, scheduleBatch, func(st *pb.ScheduledTweet) (*store.PublishedTweet, error) {
+		tweet, parentUserID, err := s.prepareTweet(ctx, st.UserId, &pb.CreateTweetRequest{
+			Content:      st.Content,
+			ReplyTo:      st.ReplyTo,
+			QuoteTweetId: st.QuoteTweetId,
+		})
+		if err != nil {
+			return nil, errors.New(status.Convert(err).Message())
+		}
+		return &store.PublishedTweet{
+			Scheduled:    st,
+			Tweet:        tweet,
+			ParentUserID: parentUserID,
+			Events:       createdEvents(st.UserId, st.Username, tweet, parentUserID),
+		}, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, pt := range published {
+		s.published(ctx, pt.Scheduled.UserId, pt.Tweet)
+	}
+	return len(published), nil
+}
+
+// validatePublishAt check that a publication date is in the future,
+// a nil date is valid and saves a draft.
+func validatePublishAt(publishAt *timestamp.Timestamp) error {
+	if publishAt == nil {
+		return nil
+	}
+
+	t, err := ptypes.Timestamp(publishAt)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid publish_at: %v", err)
+	}
+	if !t.After(time.Now()) {
+		return status.Errorf(codes.InvalidArgument, "publish_at should be in the future")
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package tweet
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/search"
+)
+
+// SearchTweets full text search tweets, most relevant first.
+func (s *Server) SearchTweets(req *pb.SearchTweetsRequest, stream pb.TweetService_SearchTweetsServer) error {
+	ctx := stream.Context()
+
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	q, err := search.ParseQuery(req.GetQuery())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid query: %v", err)
+	}
+
+	after, err := search.DecodeCursor(req.GetPageToken())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	limit := req.GetLimit()
+	if limit <= 0 || limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	ids, err := s.tweetStore.FindUserIDs(ctx, append(q.From, q.ExcludedFrom...))
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not find users: %v", err)
+	}
+
+	q.FromUserIDs = resolveUsernames(q.From, ids)
+	q.ExcludedFromUserIDs = resolveUsernames(q.ExcludedFrom, ids)
+
+	// none of the requested authors exists.
+	if len(q.From) > 0 && len(q.FromUserIDs) == 0 {
+		return nil
+	}
+
+	hits, err := s.searcher.Search(ctx, q, after, int(limit))
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not search tweets: %v", err)
+	}
+
+	hitIDs := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		hitIDs = append(hitIDs, hit.ID)
+	}
+
+	tweets, err := s.tweetStore.GetMany(ctx, userInfos.ID, hitIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get tweets: %v", err)
+	}
+
+	byID := make(map[int64]*pb.Tweet, len(tweets))
+	for _, tweet := range tweets {
+		byID[tweet.Id] = tweet
+	}
+
+	for _, hit := range hits {
+		tweet, ok := byID[hit.ID]
+		if !ok {
+			continue
+		}
+
+		err := stream.Send(&pb.SearchTweetsResponse{
+			Tweet:         tweet,
+			Rank:          hit.Rank,
+			NextPageToken: search.CursorOf(hit).Encode(),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not send tweet: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// index add a tweet to the search index, failures are logged only since
+// the tweet is already stored.
+func (s *Server) index(ctx context.Context, tweet *pb.Tweet) {
+	// only public tweets can be searched.
+	if tweet.Visibility != pb.Visibility_PUBLIC {
+		return
+	}
+
+	err := s.searcher.Index(ctx, tweet)
+	if err != nil {
+		log.Printf("Could not index tweet %d: %v", tweet.Id, err)
+	}
+}
+
+// resolveUsernames return the ids of known usernames.
+func resolveUsernames(usernames []string, ids map[string]int64) []int64 {
+	resolved := []int64{}
+	for _, username := range usernames {
+		if id, ok := ids[strings.ToLower(username)]; ok {
+			resolved = append(resolved, id)
+		}
+	}
+	return resolved
+}
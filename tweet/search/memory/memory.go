@@ -0,0 +1,279 @@
+package memorysearch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/search"
+)
+
+// MemorySearcher in memory inverted index.
+type MemorySearcher struct {
+	mu sync.RWMutex
+	// docs indexed tweets by id.
+	docs map[int64]*document
+	// postings token positions by tweet id.
+	postings map[string]map[int64][]int
+}
+
+// document an indexed tweet.
+type document struct {
+	id        int64
+	userID    int64
+	createdAt time.Time
+	tokens    []string
+	hashtags  map[string]bool
+}
+
+// NewMemorySearcher create new in memory searcher
+func NewMemorySearcher() *MemorySearcher {
+	return &MemorySearcher{
+		docs:     map[int64]*document{},
+		postings: map[string]map[int64][]int{},
+	}
+}
+
+// Index a tweet, an already indexed tweet is replaced.
+func (m *MemorySearcher) Index(ctx context.Context, tweet *pb.Tweet) error {
+	userID, err := strconv.ParseInt(tweet.UserId, 10, 64)
+	if err != nil {
+		return fmt.Errorf("Invalid tweet user id: %v", err)
+	}
+
+	createdAt := time.Now()
+	if tweet.CreatedAt != nil {
+		createdAt, err = ptypes.Timestamp(tweet.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("Invalid tweet created at: %v", err)
+		}
+	}
+
+	doc := &document{
+		id:        tweet.Id,
+		userID:    userID,
+		createdAt: createdAt,
+		tokens:    search.Tokenize(tweet.Content),
+		hashtags:  map[string]bool{},
+	}
+	for _, e := range tweet.Entities {
+		if e.Type == pb.EntityType_ENTITY_HASHTAG {
+			doc.hashtags[strings.ToLower(e.Text)] = true
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(tweet.Id)
+	m.docs[doc.id] = doc
+	for pos, token := range doc.tokens {
+		posting, ok := m.postings[token]
+		if !ok {
+			posting = map[int64][]int{}
+			m.postings[token] = posting
+		}
+		posting[doc.id] = append(posting[doc.id], pos)
+	}
+
+	return nil
+}
+
+// Remove a tweet.
+func (m *MemorySearcher) Remove(ctx context.Context, id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(id)
+	return nil
+}
+
+// remove a tweet, the lock must be held.
+func (m *MemorySearcher) remove(id int64) {
+	doc, ok := m.docs[id]
+	if !ok {
+		return
+	}
+
+	for _, token := range doc.tokens {
+		posting := m.postings[token]
+		delete(posting, id)
+		if len(posting) == 0 {
+			delete(m.postings, token)
+		}
+	}
+	delete(m.docs, id)
+}
+
+// Search tweets
+func (m *MemorySearcher) Search(ctx context.Context, q *search.Query, after *search.Cursor, limit int) ([]*search.Hit, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	required := append([]string{}, q.Terms...)
+	for _, phrase := range q.Phrases {
+		required = append(required, phrase...)
+	}
+
+	hits := []*search.Hit{}
+	for _, doc := range m.candidates(required) {
+		if !m.match(doc, q) {
+			continue
+		}
+
+		hit := &search.Hit{ID: doc.id, Rank: m.rank(doc, required)}
+		if after != nil && !after.After(hit) {
+			continue
+		}
+		hits = append(hits, hit)
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		return search.CursorOf(hits[i]).After(hits[j])
+	})
+
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+// candidates return documents containing all the tokens, every document
+// when there is no token.
+func (m *MemorySearcher) candidates(tokens []string) []*document {
+	docs := []*document{}
+
+	if len(tokens) == 0 {
+		for _, doc := range m.docs {
+			docs = append(docs, doc)
+		}
+		return docs
+	}
+
+	// walk the smallest posting list.
+	smallest := m.postings[tokens[0]]
+	for _, token := range tokens[1:] {
+		if len(m.postings[token]) < len(smallest) {
+			smallest = m.postings[token]
+		}
+	}
+
+	for id := range smallest {
+		found := true
+		for _, token := range tokens {
+			if _, ok := m.postings[token][id]; !ok {
+				found = false
+				break
+			}
+		}
+		if found {
+			docs = append(docs, m.docs[id])
+		}
+	}
+	return docs
+}
+
+// match reports whether a document matches the query filters.
+func (m *MemorySearcher) match(doc *document, q *search.Query) bool {
+	for _, phrase := range q.Phrases {
+		if !m.hasPhrase(doc.id, phrase) {
+			return false
+		}
+	}
+	for _, term := range q.ExcludedTerms {
+		if _, ok := m.postings[term][doc.id]; ok {
+			return false
+		}
+	}
+	for _, phrase := range q.ExcludedPhrases {
+		if m.hasPhrase(doc.id, phrase) {
+			return false
+		}
+	}
+
+	if len(q.FromUserIDs) > 0 && !containsID(q.FromUserIDs, doc.userID) {
+		return false
+	}
+	if containsID(q.ExcludedFromUserIDs, doc.userID) {
+		return false
+	}
+
+	for _, tag := range q.Hashtags {
+		if !doc.hashtags[tag] {
+			return false
+		}
+	}
+	for _, tag := range q.ExcludedHashtags {
+		if doc.hashtags[tag] {
+			return false
+		}
+	}
+
+	if !q.Since.IsZero() && doc.createdAt.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !doc.createdAt.Before(q.Until) {
+		return false
+	}
+	return true
+}
+
+// hasPhrase reports whether the words appear consecutively in a document.
+func (m *MemorySearcher) hasPhrase(id int64, words []string) bool {
+	for _, start := range m.postings[words[0]][id] {
+		found := true
+		for i, word := range words[1:] {
+			if !containsPos(m.postings[word][id], start+i+1) {
+				found = false
+				break
+			}
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// rank a document by the frequency of the query tokens.
+func (m *MemorySearcher) rank(doc *document, tokens []string) float32 {
+	if len(tokens) == 0 {
+		return 0
+	}
+
+	count := 0
+	for _, token := range tokens {
+		count += len(m.postings[token][doc.id])
+	}
+	return float32(count) / float32(len(doc.tokens)+1)
+}
+
+// Close is a no-op.
+func (m *MemorySearcher) Close() error {
+	return nil
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPos(positions []int, pos int) bool {
+	for _, p := range positions {
+		if p == pos {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package memorysearch_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/entity"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/search"
+	memorysearch "github.com/idirall22/twee/tweet/search/memory"
+)
+
+func TestMemorySearcher(t *testing.T) {
+	ctx := context.Background()
+	searcher := memorysearch.NewMemorySearcher()
+
+	day := func(d int) time.Time {
+		return time.Date(2020, time.June, d, 12, 0, 0, 0, time.UTC)
+	}
+
+	tweets := []struct {
+		id      int64
+		userID  string
+		content string
+		at      time.Time
+	}{
+		{1, "1", "Learning go is fun #golang", day(1)},
+		{2, "2", "go is fun, go is fast #golang", day(2)},
+		{3, "1", "fun with rust", day(3)},
+		{4, "2", "is go fun?", day(4)},
+	}
+
+	for _, tw := range tweets {
+		createdAt, err := ptypes.TimestampProto(tw.at)
+		require.NoError(t, err)
+
+		err = searcher.Index(ctx, &pb.Tweet{
+			Id:        tw.id,
+			UserId:    tw.userID,
+			Content:   tw.content,
+			CreatedAt: createdAt,
+			Entities:  entity.Parse(tw.content),
+		})
+		require.NoError(t, err)
+	}
+
+	ids := func(raw string, fromIDs ...int64) []int64 {
+		q, err := search.ParseQuery(raw)
+		require.NoError(t, err)
+		q.FromUserIDs = fromIDs
+
+		hits, err := searcher.Search(ctx, q, nil, 10)
+		require.NoError(t, err)
+
+		res := []int64{}
+		for _, hit := range hits {
+			res = append(res, hit.ID)
+		}
+		return res
+	}
+
+	// matches in shorter tweets rank first.
+	require.Equal(t, []int64{4, 2, 1}, ids("go fun"))
+	require.ElementsMatch(t, []int64{1, 2}, ids(`"go is fun"`))
+	require.ElementsMatch(t, []int64{4}, ids(`go -"go is fun"`))
+	require.ElementsMatch(t, []int64{1, 3}, ids("fun", 1))
+	require.ElementsMatch(t, []int64{1, 2}, ids("#golang"))
+	require.ElementsMatch(t, []int64{3, 4}, ids("fun -#golang"))
+	require.ElementsMatch(t, []int64{2, 3}, ids("fun since:2020-06-02 until:2020-06-04"))
+
+	// paginate one hit at a time.
+	q, err := search.ParseQuery("fun")
+	require.NoError(t, err)
+
+	seen := []int64{}
+	var cursor *search.Cursor
+	for {
+		hits, err := searcher.Search(ctx, q, cursor, 1)
+		require.NoError(t, err)
+		if len(hits) == 0 {
+			break
+		}
+		require.Len(t, hits, 1)
+
+		token := search.CursorOf(hits[0]).Encode()
+		cursor, err = search.DecodeCursor(token)
+		require.NoError(t, err)
+		seen = append(seen, hits[0].ID)
+	}
+	require.ElementsMatch(t, []int64{1, 2, 3, 4}, seen)
+
+	// removed tweets are not found anymore.
+	require.NoError(t, searcher.Remove(ctx, 2))
+	require.ElementsMatch(t, []int64{1}, ids("#golang"))
+
+	_, err = search.ParseQuery("  ")
+	require.Error(t, err)
+	_, err = search.ParseQuery("since:yesterday")
+	require.Error(t, err)
+}
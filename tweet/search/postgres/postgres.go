@@ -0,0 +1,146 @@
+package postgressearch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+
+	"github.com/idirall22/twee/common"
+	option "github.com/idirall22/twee/options"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/search"
+)
+
+// PostgresSearcher search tweets using the tweets search_vector column.
+type PostgresSearcher struct {
+	options *option.PostgresOptions
+	db      *sql.DB
+	logger  *zap.Logger
+}
+
+// NewPostgresSearcher create new postgres searcher
+func NewPostgresSearcher(opts *option.PostgresOptions) (*PostgresSearcher, error) {
+	logger, db, err := common.SetupPostgres(opts)
+	if err != nil {
+		return nil, fmt.Errorf("Could not connect to db: %v", err)
+	}
+
+	return &PostgresSearcher{
+		options: opts,
+		db:      db,
+		logger:  logger,
+	}, nil
+}
+
+// Index is a no-op, the search vector is generated by postgres.
+func (p *PostgresSearcher) Index(ctx context.Context, tweet *pb.Tweet) error {
+	return nil
+}
+
+// Remove is a no-op, deleted tweets are filtered out by Search.
+func (p *PostgresSearcher) Remove(ctx context.Context, id int64) error {
+	return nil
+}
+
+// Search tweets
+func (p *PostgresSearcher) Search(ctx context.Context, q *search.Query, after *search.Cursor, limit int) ([]*search.Hit, error) {
+	b := &queryBuilder{}
+
+	conds := []string{"deleted_at IS NULL", "retweet_of_id IS NULL", "visibility = 'PUBLIC'"}
+
+	matches := []string{}
+	for _, term := range q.Terms {
+		matches = append(matches, "plainto_tsquery('simple', "+b.arg(term)+")")
+	}
+	for _, phrase := range q.Phrases {
+		matches = append(matches, "phraseto_tsquery('simple', "+b.arg(strings.Join(phrase, " "))+")")
+	}
+
+	rank := "0::real"
+	if len(matches) > 0 {
+		match := "(" + strings.Join(matches, " && ") + ")"
+		conds = append(conds, "search_vector @@ "+match)
+		rank = "ts_rank(search_vector, " + match + ")"
+	}
+
+	for _, term := range q.ExcludedTerms {
+		conds = append(conds, "NOT search_vector @@ plainto_tsquery('simple', "+b.arg(term)+")")
+	}
+	for _, phrase := range q.ExcludedPhrases {
+		conds = append(conds, "NOT search_vector @@ phraseto_tsquery('simple', "+b.arg(strings.Join(phrase, " "))+")")
+	}
+
+	if len(q.FromUserIDs) > 0 {
+		conds = append(conds, "user_id = ANY("+b.arg(pq.Array(q.FromUserIDs))+"::int[])")
+	}
+	if len(q.ExcludedFromUserIDs) > 0 {
+		conds = append(conds, "NOT user_id = ANY("+b.arg(pq.Array(q.ExcludedFromUserIDs))+"::int[])")
+	}
+
+	for _, tag := range q.Hashtags {
+		conds = append(conds, "EXISTS (SELECT 1 FROM tweet_hashtags h WHERE h.tweet_id = tweets.id AND lower(h.tag) = "+b.arg(tag)+")")
+	}
+	for _, tag := range q.ExcludedHashtags {
+		conds = append(conds, "NOT EXISTS (SELECT 1 FROM tweet_hashtags h WHERE h.tweet_id = tweets.id AND lower(h.tag) = "+b.arg(tag)+")")
+	}
+
+	if !q.Since.IsZero() {
+		conds = append(conds, "created_at >= "+b.arg(q.Since))
+	}
+	if !q.Until.IsZero() {
+		conds = append(conds, "created_at < "+b.arg(q.Until))
+	}
+
+	page := ""
+	if after != nil {
+		page = fmt.Sprintf("WHERE (rank, id) < (%s::real, %s)", b.arg(after.Rank), b.arg(after.ID))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, rank FROM (
+			SELECT id, %s AS rank FROM tweets WHERE %s
+		) AS hits
+		%s
+		ORDER BY rank DESC, id DESC
+		LIMIT %s`,
+		rank, strings.Join(conds, " AND "), page, b.arg(limit),
+	)
+
+	rows, err := p.db.QueryContext(ctx, query, b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("Could not search tweets: %v", err)
+	}
+	defer rows.Close()
+
+	hits := []*search.Hit{}
+	for rows.Next() {
+		hit := &search.Hit{}
+		err := rows.Scan(&hit.ID, &hit.Rank)
+		if err != nil {
+			return nil, fmt.Errorf("Could not scan hit: %v", err)
+		}
+		hits = append(hits, hit)
+	}
+
+	return hits, rows.Err()
+}
+
+// Close db connection
+func (p *PostgresSearcher) Close() error {
+	return p.db.Close()
+}
+
+// queryBuilder collect query arguments.
+type queryBuilder struct {
+	args []interface{}
+}
+
+// arg add an argument and return its placeholder.
+func (b *queryBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
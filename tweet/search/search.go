@@ -0,0 +1,242 @@
+package search
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/idirall22/twee/pb"
+)
+
+// Searcher tweets search index interface.
+type Searcher interface {
+	// Index add or replace a tweet in the index.
+	Index(ctx context.Context, tweet *pb.Tweet) error
+	// Remove a tweet from the index.
+	Remove(ctx context.Context, id int64) error
+	// Search tweets matching the query ranked by relevance, only tweets
+	// ranked after the cursor are returned when it is not nil.
+	Search(ctx context.Context, q *Query, after *Cursor, limit int) ([]*Hit, error)
+	// Close index.
+	Close() error
+}
+
+// Hit a tweet matching a query.
+type Hit struct {
+	ID   int64
+	Rank float32
+}
+
+// Cursor position of a hit in the results, hits are ordered by rank then id
+// both descending.
+type Cursor struct {
+	Rank float32
+	ID   int64
+}
+
+// CursorOf return the cursor positioned on a hit.
+func CursorOf(h *Hit) *Cursor {
+	return &Cursor{Rank: h.Rank, ID: h.ID}
+}
+
+// After reports whether the hit is ranked after the cursor.
+func (c *Cursor) After(h *Hit) bool {
+	if h.Rank != c.Rank {
+		return h.Rank < c.Rank
+	}
+	return h.ID < c.ID
+}
+
+// Encode return an opaque page token.
+func (c *Cursor) Encode() string {
+	raw := strconv.FormatFloat(float64(c.Rank), 'g', -1, 32) + ":" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor decode a page token, an empty token return a nil cursor.
+func DecodeCursor(token string) (*Cursor, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid page token: %v", err)
+	}
+
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid page token")
+	}
+
+	rank, err := strconv.ParseFloat(parts[0], 32)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid page token rank: %v", err)
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid page token id: %v", err)
+	}
+
+	return &Cursor{Rank: float32(rank), ID: id}, nil
+}
+
+// Query parsed search query.
+type Query struct {
+	// Terms words that must appear.
+	Terms []string
+	// Phrases sequences of words that must appear.
+	Phrases [][]string
+	// ExcludedTerms words that must not appear.
+	ExcludedTerms []string
+	// ExcludedPhrases sequences of words that must not appear.
+	ExcludedPhrases [][]string
+	// From usernames of accepted authors.
+	From []string
+	// ExcludedFrom usernames of rejected authors.
+	ExcludedFrom []string
+	// FromUserIDs resolved From usernames.
+	FromUserIDs []int64
+	// ExcludedFromUserIDs resolved ExcludedFrom usernames.
+	ExcludedFromUserIDs []int64
+	// Hashtags lower case hashtags without # that must be used.
+	Hashtags []string
+	// ExcludedHashtags lower case hashtags without # that must not be used.
+	ExcludedHashtags []string
+	// Since tweets created from this date, ignored if zero.
+	Since time.Time
+	// Until tweets created before this date, ignored if zero.
+	Until time.Time
+}
+
+// dateLayout layout of since: and until: dates.
+const dateLayout = "2006-01-02"
+
+// ParseQuery parse a search query, supported syntax:
+//
+//	word "a phrase" from:username #hashtag since:2020-01-02 until:2020-02-01
+//
+// words, phrases, from: and hashtags are excluded when prefixed by -.
+func ParseQuery(raw string) (*Query, error) {
+	q := &Query{}
+
+	for _, token := range splitQuery(raw) {
+		excluded := false
+		if len(token) > 1 && token[0] == '-' {
+			excluded = true
+			token = token[1:]
+		}
+
+		switch {
+		case token[0] == '"':
+			words := Tokenize(strings.Trim(token, `"`))
+			if len(words) == 0 {
+				continue
+			}
+			if excluded {
+				q.ExcludedPhrases = append(q.ExcludedPhrases, words)
+			} else {
+				q.Phrases = append(q.Phrases, words)
+			}
+
+		case strings.HasPrefix(token, "from:"):
+			username := strings.TrimPrefix(strings.TrimPrefix(token, "from:"), "@")
+			if len(username) == 0 {
+				return nil, fmt.Errorf("Empty from: username")
+			}
+			if excluded {
+				q.ExcludedFrom = append(q.ExcludedFrom, username)
+			} else {
+				q.From = append(q.From, username)
+			}
+
+		case len(token) > 1 && token[0] == '#':
+			tag := strings.ToLower(token[1:])
+			if excluded {
+				q.ExcludedHashtags = append(q.ExcludedHashtags, tag)
+			} else {
+				q.Hashtags = append(q.Hashtags, tag)
+			}
+
+		case !excluded && strings.HasPrefix(token, "since:"):
+			t, err := time.Parse(dateLayout, strings.TrimPrefix(token, "since:"))
+			if err != nil {
+				return nil, fmt.Errorf("Invalid since: date: %v", err)
+			}
+			q.Since = t
+
+		case !excluded && strings.HasPrefix(token, "until:"):
+			t, err := time.Parse(dateLayout, strings.TrimPrefix(token, "until:"))
+			if err != nil {
+				return nil, fmt.Errorf("Invalid until: date: %v", err)
+			}
+			q.Until = t
+
+		default:
+			words := Tokenize(token)
+			if excluded {
+				q.ExcludedTerms = append(q.ExcludedTerms, words...)
+			} else {
+				q.Terms = append(q.Terms, words...)
+			}
+		}
+	}
+
+	if q.IsEmpty() {
+		return nil, fmt.Errorf("Empty query")
+	}
+	return q, nil
+}
+
+// IsEmpty reports whether the query has no criteria.
+func (q *Query) IsEmpty() bool {
+	return len(q.Terms) == 0 && len(q.Phrases) == 0 &&
+		len(q.ExcludedTerms) == 0 && len(q.ExcludedPhrases) == 0 &&
+		len(q.From) == 0 && len(q.ExcludedFrom) == 0 &&
+		len(q.Hashtags) == 0 && len(q.ExcludedHashtags) == 0 &&
+		q.Since.IsZero() && q.Until.IsZero()
+}
+
+// Tokenize split a text in lower case words.
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// splitQuery split a query on spaces, quoted phrases are kept together.
+func splitQuery(raw string) []string {
+	tokens := []string{}
+	current := &strings.Builder{}
+	quoted := false
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range raw {
+		switch {
+		case r == '"':
+			current.WriteRune(r)
+			if quoted {
+				flush()
+			}
+			quoted = !quoted
+		case unicode.IsSpace(r) && !quoted:
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
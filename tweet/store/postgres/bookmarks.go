@@ -0,0 +1,235 @@
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
+)
+
+// Bookmark a tweet, an existing bookmark is moved to the folder.
+func (p *PostgresTweetStore) Bookmark(ctx context.Context, userID int64, tweetID int64, folderID int64) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if folderID != 0 {
+		var exists bool
+		err = tx.QueryRowContext(
+			ctx,
+			"SELECT true FROM bookmark_folders WHERE id=$1 AND user_id=$2",
+			folderID, userID,
+		).Scan(&exists)
+		if err == sql.ErrNoRows {
+			return utils.ErrNotExists
+		}
+		if err != nil {
+			return fmt.Errorf("Could not get bookmark folder: %v", err)
+		}
+	}
+
+	_, err = tx.ExecContext(
+		ctx, `
+		INSERT INTO bookmarks (user_id, tweet_id, folder_id) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, tweet_id) DO UPDATE SET folder_id = EXCLUDED.folder_id`,
+		userID, tweetID, nullID(folderID),
+	)
+	if err != nil {
+		return fmt.Errorf("Could not create a record: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Could not commit transaction: %v", err)
+	}
+	return nil
+}
+
+// Unbookmark remove a bookmark.
+func (p *PostgresTweetStore) Unbookmark(ctx context.Context, userID int64, tweetID int64) error {
+	result, err := p.db.ExecContext(
+		ctx,
+		"DELETE FROM bookmarks WHERE user_id=$1 AND tweet_id=$2",
+		userID, tweetID,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not delete a record: %v", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("Could not get affected rows: %v", err)
+	}
+	if count == 0 {
+		return utils.ErrNotExists
+	}
+	return nil
+}
+
+// ListBookmarks list bookmarked tweets most recently bookmarked first,
+// deleted tweets and tweets the user can not see anymore are skipped.
+func (p *PostgresTweetStore) ListBookmarks(
+	ctx context.Context,
+	userID int64,
+	folderID int64,
+	limit, offset int32,
+) ([]*pb.Tweet, error) {
+
+	rows, err := p.db.QueryContext(
+		ctx, `
+		SELECT `+tweetColumns+` FROM tweets
+		INNER JOIN (
+			SELECT id AS bookmark_id, tweet_id FROM bookmarks
+			WHERE user_id=$1 AND ($2 = 0 OR folder_id=$2)
+		) b ON b.tweet_id = tweets.id
+		WHERE deleted_at IS NULL AND `+common.TweetVisibleTo("tweets", "$1")+`
+		ORDER BY b.bookmark_id DESC
+		LIMIT $3 OFFSET $4`,
+		userID, folderID, limit, offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get bookmarks: %v", err)
+	}
+	defer rows.Close()
+
+	tweets, err := scanTweets(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	err = hydrate(ctx, p.db, userID, tweets)
+	if err != nil {
+		return nil, err
+	}
+	return tweets, nil
+}
+
+// CreateBookmarkFolder create a bookmark folder, names are unique per user
+// ignoring case.
+func (p *PostgresTweetStore) CreateBookmarkFolder(ctx context.Context, userID int64, name string) (*pb.BookmarkFolder, error) {
+	folder := &pb.BookmarkFolder{Name: name}
+	var createdAt time.Time
+	err := p.db.QueryRowContext(
+		ctx, `
+		INSERT INTO bookmark_folders (user_id, name) VALUES ($1, $2)
+		ON CONFLICT (user_id, lower(name)) DO NOTHING
+		RETURNING id, created_at`,
+		userID, name,
+	).Scan(&folder.Id, &createdAt)
+
+	if err == sql.ErrNoRows {
+		return nil, utils.ErrAlreadyExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not create a record: %v", err)
+	}
+
+	folder.CreatedAt, _ = ptypes.TimestampProto(createdAt)
+	return folder, nil
+}
+
+// ListBookmarkFolders list a user bookmark folders oldest first, deleted
+// tweets are not counted.
+func (p *PostgresTweetStore) ListBookmarkFolders(ctx context.Context, userID int64) ([]*pb.BookmarkFolder, error) {
+	rows, err := p.db.QueryContext(
+		ctx, `
+		SELECT f.id, f.name, f.created_at, COUNT(t.id)
+		FROM bookmark_folders f
+		LEFT JOIN bookmarks b ON b.folder_id = f.id
+		LEFT JOIN tweets t ON t.id = b.tweet_id AND t.deleted_at IS NULL
+		WHERE f.user_id=$1
+		GROUP BY f.id
+		ORDER BY f.created_at, f.id`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get bookmark folders: %v", err)
+	}
+	defer rows.Close()
+
+	folders := []*pb.BookmarkFolder{}
+	for rows.Next() {
+		folder := &pb.BookmarkFolder{}
+		var createdAt time.Time
+		err = rows.Scan(&folder.Id, &folder.Name, &createdAt, &folder.BookmarkCount)
+		if err != nil {
+			return nil, fmt.Errorf("Could not scan bookmark folder: %v", err)
+		}
+		folder.CreatedAt, _ = ptypes.TimestampProto(createdAt)
+		folders = append(folders, folder)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("Could not iterate bookmark folders: %v", err)
+	}
+	return folders, nil
+}
+
+// DeleteBookmarkFolder delete a bookmark folder and its bookmarks.
+func (p *PostgresTweetStore) DeleteBookmarkFolder(ctx context.Context, userID int64, id int64) error {
+	result, err := p.db.ExecContext(
+		ctx,
+		"DELETE FROM bookmark_folders WHERE id=$1 AND user_id=$2",
+		id, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not delete a record: %v", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("Could not get affected rows: %v", err)
+	}
+	if count == 0 {
+		return utils.ErrNotExists
+	}
+	return nil
+}
+
+// hydrateBookmarks set tweets bookmarked by the viewer.
+func hydrateBookmarks(ctx context.Context, q queryer, viewerID int64, tweets []*pb.Tweet) error {
+	if viewerID == 0 || len(tweets) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(tweets))
+	for _, t := range tweets {
+		ids = append(ids, t.Id)
+	}
+
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT tweet_id FROM bookmarks WHERE user_id=$1 AND tweet_id = ANY($2::int[])",
+		viewerID, pq.Array(ids),
+	)
+	if err != nil {
+		return fmt.Errorf("Could not get bookmarks: %v", err)
+	}
+	defer rows.Close()
+
+	bookmarked := map[int64]bool{}
+	for rows.Next() {
+		var id int64
+		err = rows.Scan(&id)
+		if err != nil {
+			return fmt.Errorf("Could not scan bookmark: %v", err)
+		}
+		bookmarked[id] = true
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("Could not iterate bookmarks: %v", err)
+	}
+
+	for _, t := range tweets {
+		t.Bookmarked = bookmarked[t.Id]
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+)
+
+// FindUserIDs get users id by username, the map is keyed by lower case username.
+func (p *PostgresTweetStore) FindUserIDs(ctx context.Context, usernames []string) (map[string]int64, error) {
+	ids := map[string]int64{}
+	if len(usernames) == 0 {
+		return ids, nil
+	}
+
+	lower := make([]string, 0, len(usernames))
+	for _, u := range usernames {
+		lower = append(lower, strings.ToLower(u))
+	}
+
+	rows, err := p.db.QueryContext(
+		ctx,
+		"SELECT id, lower(username) FROM users WHERE lower(username) = ANY($1::varchar[])",
+		pq.Array(lower),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not find users: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var username string
+		err = rows.Scan(&id, &username)
+		if err != nil {
+			return nil, fmt.Errorf("Could not scan user: %v", err)
+		}
+		ids[username] = id
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("Could not iterate users: %v", err)
+	}
+	return ids, nil
+}
+
+// ListHashtag list tweets with a hashtag, newest first.
+func (p *PostgresTweetStore) ListHashtag(
+	ctx context.Context,
+	viewerID int64,
+	tag string,
+	limit, offset int32,
+) ([]*pb.Tweet, error) {
+
+	rows, err := p.db.QueryContext(
+		ctx, `
+		SELECT `+tweetColumns+` FROM tweets
+		WHERE deleted_at IS NULL AND id IN (
+			SELECT tweet_id FROM tweet_hashtags WHERE lower(tag) = lower($1)
+		) AND `+common.TweetVisibleTo("tweets", "$4")+`
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2 OFFSET $3`,
+		tag, limit, offset, viewerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get hashtag tweets: %v", err)
+	}
+	defer rows.Close()
+
+	tweets, err := scanTweets(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	err = hydrate(ctx, p.db, viewerID, tweets)
+	if err != nil {
+		return nil, err
+	}
+	return tweets, nil
+}
+
+// replaceEntities replace a tweet entities.
+func replaceEntities(ctx context.Context, tx *sql.Tx, tweetID int64, entities []*pb.TweetEntity) error {
+	for _, table := range []string{"tweet_hashtags", "tweet_mentions", "tweet_urls"} {
+		_, err := tx.ExecContext(ctx, "DELETE FROM "+table+" WHERE tweet_id=$1", tweetID)
+		if err != nil {
+			return fmt.Errorf("Could not delete %s: %v", table, err)
+		}
+	}
+	return insertEntities(ctx, tx, tweetID, entities)
+}
+
+// insertEntities index a tweet entities in their own tables.
+func insertEntities(ctx context.Context, tx *sql.Tx, tweetID int64, entities []*pb.TweetEntity) error {
+	for _, e := range entities {
+		var err error
+
+		switch e.Type {
+		case pb.EntityType_ENTITY_HASHTAG:
+			_, err = tx.ExecContext(
+				ctx, `
+				INSERT INTO tweet_hashtags (tweet_id, tag, start_offset, end_offset, rune_start, rune_end)
+				VALUES ($1, $2, $3, $4, $5, $6)`,
+				tweetID, e.Text, e.Start, e.End, e.RuneStart, e.RuneEnd,
+			)
+		case pb.EntityType_ENTITY_MENTION:
+			_, err = tx.ExecContext(
+				ctx, `
+				INSERT INTO tweet_mentions (tweet_id, user_id, username, start_offset, end_offset, rune_start, rune_end)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+				tweetID, e.UserId, e.Text, e.Start, e.End, e.RuneStart, e.RuneEnd,
+			)
+		case pb.EntityType_ENTITY_URL:
+			_, err = tx.ExecContext(
+				ctx, `
+				INSERT INTO tweet_urls (tweet_id, url, start_offset, end_offset, rune_start, rune_end)
+				VALUES ($1, $2, $3, $4, $5, $6)`,
+				tweetID, e.Text, e.Start, e.End, e.RuneStart, e.RuneEnd,
+			)
+		}
+
+		if err != nil {
+			return fmt.Errorf("Could not create %s entity: %v", e.Type, err)
+		}
+	}
+	return nil
+}
+
+// hydrateEntities set tweets entities ordered by offset.
+func hydrateEntities(ctx context.Context, q queryer, tweets []*pb.Tweet) error {
+	if len(tweets) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*pb.Tweet, len(tweets))
+	ids := make([]int64, 0, len(tweets))
+	for _, t := range tweets {
+		byID[t.Id] = t
+		ids = append(ids, t.Id)
+	}
+
+	rows, err := q.QueryContext(
+		ctx, `
+		SELECT tweet_id, $2::int, tag, 0, start_offset, end_offset, rune_start, rune_end
+		FROM tweet_hashtags WHERE tweet_id = ANY($1::int[])
+		UNION ALL
+		SELECT tweet_id, $3::int, username, user_id, start_offset, end_offset, rune_start, rune_end
+		FROM tweet_mentions WHERE tweet_id = ANY($1::int[])
+		UNION ALL
+		SELECT tweet_id, $4::int, url, 0, start_offset, end_offset, rune_start, rune_end
+		FROM tweet_urls WHERE tweet_id = ANY($1::int[])
+		ORDER BY 1, 5`,
+		pq.Array(ids),
+		pb.EntityType_ENTITY_HASHTAG,
+		pb.EntityType_ENTITY_MENTION,
+		pb.EntityType_ENTITY_URL,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not get entities: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tweetID int64
+		e := &pb.TweetEntity{}
+		err = rows.Scan(
+			&tweetID,
+			&e.Type,
+			&e.Text,
+			&e.UserId,
+			&e.Start,
+			&e.End,
+			&e.RuneStart,
+			&e.RuneEnd,
+		)
+		if err != nil {
+			return fmt.Errorf("Could not scan entity: %v", err)
+		}
+
+		if t, ok := byID[tweetID]; ok {
+			t.Entities = append(t.Entities, e)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("Could not iterate entities: %v", err)
+	}
+	return nil
+}
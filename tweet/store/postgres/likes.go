@@ -0,0 +1,196 @@
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
+)
+
+// Like a tweet.
+func (p *PostgresTweetStore) Like(ctx context.Context, userID int64, tweetID int64, events ...*pb.TweetEvent) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// lock the tweet to keep the like count consistent.
+	var exists bool
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT true FROM tweets WHERE id=$1 AND deleted_at IS NULL AND retweet_of_id IS NULL FOR UPDATE",
+		tweetID,
+	).Scan(&exists)
+
+	if err == sql.ErrNoRows {
+		return utils.ErrNotExists
+	}
+	if err != nil {
+		return fmt.Errorf("Could not get tweet: %v", err)
+	}
+
+	result, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO likes (user_id, tweet_id) VALUES ($1, $2) ON CONFLICT (user_id, tweet_id) DO NOTHING",
+		userID, tweetID,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not create a record: %v", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("Could not get affected rows: %v", err)
+	}
+	if count == 0 {
+		return utils.ErrAlreadyExists
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE tweets SET like_count=like_count+1 WHERE id=$1", tweetID)
+	if err != nil {
+		return fmt.Errorf("Could not update like count: %v", err)
+	}
+
+	err = insertOutbox(ctx, tx, tweetID, events)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Could not commit transaction: %v", err)
+	}
+	return nil
+}
+
+// Unlike a tweet.
+func (p *PostgresTweetStore) Unlike(ctx context.Context, userID int64, tweetID int64) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM likes WHERE user_id=$1 AND tweet_id=$2",
+		userID, tweetID,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not delete a record: %v", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("Could not get affected rows: %v", err)
+	}
+	if count == 0 {
+		return utils.ErrNotExists
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE tweets SET like_count=GREATEST(like_count-1, 0) WHERE id=$1",
+		tweetID,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not update like count: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Could not commit transaction: %v", err)
+	}
+	return nil
+}
+
+// ListLikers list users who liked a tweet visible to the viewer, most
+// recent likes first.
+func (p *PostgresTweetStore) ListLikers(
+	ctx context.Context,
+	viewerID, tweetID int64,
+	limit, offset int32,
+	found func(user *pb.User) error,
+) error {
+
+	rows, err := p.db.QueryContext(
+		ctx, `
+		SELECT u.id, u.username, u.followee_count, u.follower_count
+		FROM likes l
+		INNER JOIN users u ON u.id = l.user_id
+		INNER JOIN tweets t ON t.id = l.tweet_id
+		WHERE l.tweet_id=$1 AND t.deleted_at IS NULL AND `+common.TweetVisibleTo("t", "$4")+`
+		ORDER BY l.id DESC
+		LIMIT $2 OFFSET $3`,
+		tweetID, limit, offset, viewerID,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not get likers: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		user := &pb.User{}
+		err = rows.Scan(
+			&user.Id,
+			&user.Username,
+			&user.FolloweeCount,
+			&user.FollowerCount,
+		)
+		if err != nil {
+			return fmt.Errorf("Could not scan user: %v", err)
+		}
+
+		err = found(user)
+		if err != nil {
+			return fmt.Errorf("Could not send user: %v", err)
+		}
+	}
+	return rows.Err()
+}
+
+// hydrateLikes set tweets liked by the viewer.
+func hydrateLikes(ctx context.Context, q queryer, viewerID int64, tweets []*pb.Tweet) error {
+	if viewerID == 0 || len(tweets) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(tweets))
+	for _, t := range tweets {
+		ids = append(ids, t.Id)
+	}
+
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT tweet_id FROM likes WHERE user_id=$1 AND tweet_id = ANY($2::int[])",
+		viewerID, pq.Array(ids),
+	)
+	if err != nil {
+		return fmt.Errorf("Could not get likes: %v", err)
+	}
+	defer rows.Close()
+
+	liked := map[int64]bool{}
+	for rows.Next() {
+		var id int64
+		err = rows.Scan(&id)
+		if err != nil {
+			return fmt.Errorf("Could not scan like: %v", err)
+		}
+		liked[id] = true
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("Could not iterate likes: %v", err)
+	}
+
+	for _, t := range tweets {
+		t.Liked = liked[t.Id]
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+
+	"github.com/idirall22/twee/pb"
+)
+
+// mediaColumns columns selected to build a media, see scanMedia.
+const mediaColumns = "m.id, m.type, m.content_type, m.size, m.width, m.height, m.sha256, m.created_at"
+
+// CreateMedia save an uploaded media.
+func (p *PostgresTweetStore) CreateMedia(ctx context.Context, userID int64, m *pb.Media) (int64, error) {
+	var id int64
+	err := p.db.QueryRowContext(
+		ctx, `
+		INSERT INTO media (user_id, type, content_type, size, width, height, sha256)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		userID, m.Type.String(), m.ContentType, m.Size, m.Width, m.Height, m.Sha256,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("Could not create a record: %v", err)
+	}
+	return id, nil
+}
+
+// FindMedia return the user media in the ids order, unknown media and
+// media of other users are skipped.
+func (p *PostgresTweetStore) FindMedia(ctx context.Context, userID int64, ids []int64) ([]*pb.Media, error) {
+	rows, err := p.db.QueryContext(
+		ctx,
+		"SELECT "+mediaColumns+" FROM media m WHERE m.user_id=$1 AND m.id = ANY($2::int[])",
+		userID, pq.Array(ids),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get media: %v", err)
+	}
+	defer rows.Close()
+
+	byID := map[int64]*pb.Media{}
+	for rows.Next() {
+		m, err := scanMedia(rows)
+		if err != nil {
+			return nil, fmt.Errorf("Could not scan media: %v", err)
+		}
+		byID[m.Id] = m
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Could not iterate media: %v", err)
+	}
+
+	found := []*pb.Media{}
+	for _, id := range ids {
+		if m, ok := byID[id]; ok {
+			found = append(found, m)
+		}
+	}
+	return found, nil
+}
+
+// insertMedia attach media to a tweet in order.
+func insertMedia(ctx context.Context, tx *sql.Tx, tweetID int64, media []*pb.Media) error {
+	for i, m := range media {
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO tweet_media (tweet_id, media_id, position) VALUES ($1, $2, $3)",
+			tweetID, m.Id, i,
+		)
+		if err != nil {
+			return fmt.Errorf("Could not attach media: %v", err)
+		}
+	}
+	return nil
+}
+
+// hydrateMedia set tweets media.
+func hydrateMedia(ctx context.Context, q queryer, tweets []*pb.Tweet) error {
+	if len(tweets) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*pb.Tweet, len(tweets))
+	ids := make([]int64, 0, len(tweets))
+	for _, t := range tweets {
+		byID[t.Id] = t
+		ids = append(ids, t.Id)
+	}
+
+	rows, err := q.QueryContext(
+		ctx, `
+		SELECT tm.tweet_id, `+mediaColumns+`
+		FROM tweet_media tm JOIN media m ON m.id = tm.media_id
+		WHERE tm.tweet_id = ANY($1::int[])
+		ORDER BY tm.tweet_id, tm.position`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		return fmt.Errorf("Could not get tweets media: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tweetID int64
+		m, err := scanMedia(rows, &tweetID)
+		if err != nil {
+			return fmt.Errorf("Could not scan media: %v", err)
+		}
+		t := byID[tweetID]
+		t.Media = append(t.Media, m)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("Could not iterate media: %v", err)
+	}
+	return nil
+}
+
+// scanMedia scan a row selected with mediaColumns, prefix are scanned
+// before the media columns.
+func scanMedia(row rowScanner, prefix ...interface{}) (*pb.Media, error) {
+	m := &pb.Media{}
+	var mediaType string
+	var createdAt time.Time
+
+	dest := append(prefix,
+		&m.Id,
+		&mediaType,
+		&m.ContentType,
+		&m.Size,
+		&m.Width,
+		&m.Height,
+		&m.Sha256,
+		&createdAt,
+	)
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Type = pb.MediaType(pb.MediaType_value[mediaType])
+	m.CreatedAt, _ = ptypes.TimestampProto(createdAt)
+	return m, nil
+}
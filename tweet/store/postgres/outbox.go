@@ -0,0 +1,127 @@
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/outbox"
+)
+
+// insertOutbox write events to the outbox, events without a tweet id are
+// about the tweet tweetID.
+func insertOutbox(ctx context.Context, tx *sql.Tx, tweetID int64, events []*pb.TweetEvent) error {
+	for _, e := range events {
+		if e.TweetId == 0 {
+			e.TweetId = tweetID
+		}
+
+		payload, err := common.ProtobufToJSON(e)
+		if err != nil {
+			return fmt.Errorf("Could not serialize event: %v", err)
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO tweet_outbox (aggregate_id, payload) VALUES ($1, $2)",
+			e.TweetId, payload,
+		)
+		if err != nil {
+			return fmt.Errorf("Could not create outbox record: %v", err)
+		}
+	}
+	return nil
+}
+
+// ClaimOutbox claim pending messages for lease, a message is only claimed
+// once every previous message of its tweet is sent.
+func (p *PostgresTweetStore) ClaimOutbox(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error) {
+	rows, err := p.db.QueryContext(
+		ctx, `
+		UPDATE tweet_outbox SET locked_until = now() + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT o.id FROM tweet_outbox o
+			WHERE o.sent_at IS NULL
+			AND o.next_attempt_at <= now()
+			AND (o.locked_until IS NULL OR o.locked_until <= now())
+			AND NOT EXISTS (
+				SELECT 1 FROM tweet_outbox b
+				WHERE b.aggregate_id = o.aggregate_id AND b.sent_at IS NULL AND b.id < o.id
+			)
+			ORDER BY o.id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, aggregate_id, payload, attempts`,
+		limit, lease.Seconds(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not claim outbox messages: %v", err)
+	}
+	defer rows.Close()
+
+	messages := []*outbox.Message{}
+	for rows.Next() {
+		m := &outbox.Message{Event: &pb.TweetEvent{}}
+		var payload string
+		err := rows.Scan(&m.ID, &m.AggregateID, &payload, &m.Attempts)
+		if err != nil {
+			return nil, fmt.Errorf("Could not scan outbox message: %v", err)
+		}
+
+		err = common.JSONToProtobufMessage(payload, m.Event)
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse outbox message %d: %v", m.ID, err)
+		}
+		messages = append(messages, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Could not iterate outbox messages: %v", err)
+	}
+
+	return messages, nil
+}
+
+// MarkOutboxSent mark a message as sent.
+func (p *PostgresTweetStore) MarkOutboxSent(ctx context.Context, id int64) error {
+	_, err := p.db.ExecContext(
+		ctx,
+		"UPDATE tweet_outbox SET sent_at=now(), locked_until=NULL WHERE id=$1",
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not update outbox record: %v", err)
+	}
+	return nil
+}
+
+// MarkOutboxFailed release a message until retryAt.
+func (p *PostgresTweetStore) MarkOutboxFailed(ctx context.Context, id int64, retryAt time.Time, reason string) error {
+	_, err := p.db.ExecContext(
+		ctx, `
+		UPDATE tweet_outbox SET attempts=attempts+1, last_error=$1, next_attempt_at=$2, locked_until=NULL
+		WHERE id=$3`,
+		reason, retryAt, id,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not update outbox record: %v", err)
+	}
+	return nil
+}
+
+// PurgeOutbox delete the messages sent before.
+func (p *PostgresTweetStore) PurgeOutbox(ctx context.Context, before time.Time) (int64, error) {
+	result, err := p.db.ExecContext(ctx, "DELETE FROM tweet_outbox WHERE sent_at < $1", before)
+	if err != nil {
+		return 0, fmt.Errorf("Could not purge outbox records: %v", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("Could not get affected rows: %v", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,303 @@
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/store"
+	"github.com/idirall22/twee/utils"
+)
+
+// Vote in a tweet poll, a user can only vote once.
+func (p *PostgresTweetStore) Vote(ctx context.Context, userID int64, tweetID int64, option int32) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var open bool
+	err = tx.QueryRowContext(
+		ctx, `
+		SELECT p.closes_at > now() FROM polls p JOIN tweets t ON t.id = p.tweet_id
+		WHERE p.tweet_id=$1 AND t.deleted_at IS NULL AND `+common.TweetVisibleTo("t", "$2"),
+		tweetID, userID,
+	).Scan(&open)
+	if err == sql.ErrNoRows {
+		return utils.ErrNotExists
+	}
+	if err != nil {
+		return fmt.Errorf("Could not get poll: %v", err)
+	}
+	if !open {
+		return utils.ErrClosed
+	}
+
+	res, err := tx.ExecContext(
+		ctx,
+		"UPDATE poll_options SET votes=votes+1 WHERE tweet_id=$1 AND position=$2",
+		tweetID, option,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not count vote: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return utils.ErrNotExists
+	}
+
+	res, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO poll_votes (tweet_id, user_id, position) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+		tweetID, userID, option,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not create a record: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return utils.ErrAlreadyExists
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Could not commit transaction: %v", err)
+	}
+	return nil
+}
+
+// ClosePolls close at most limit polls past their closing time, each poll
+// is returned once across concurrent callers, polls of deleted tweets are
+// closed without being returned.
+func (p *PostgresTweetStore) ClosePolls(ctx context.Context, limit int, events store.ClosedPollEvents) ([]*store.ClosedPoll, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(
+		ctx, `
+		SELECT p.tweet_id, t.user_id, u.username, t.deleted_at IS NOT NULL
+		FROM polls p
+		JOIN tweets t ON t.id = p.tweet_id
+		JOIN users u ON u.id = t.user_id
+		WHERE p.closed_at IS NULL AND p.closes_at <= now()
+		ORDER BY p.closes_at
+		LIMIT $1
+		FOR UPDATE OF p SKIP LOCKED`,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get polls to close: %v", err)
+	}
+
+	ids := []int64{}
+	closed := []*store.ClosedPoll{}
+	for rows.Next() {
+		c := &store.ClosedPoll{}
+		var deleted bool
+		err := rows.Scan(&c.TweetID, &c.UserID, &c.Username, &deleted)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("Could not scan poll: %v", err)
+		}
+		ids = append(ids, c.TweetID)
+		if !deleted {
+			closed = append(closed, c)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Could not iterate polls: %v", err)
+	}
+
+	if len(ids) == 0 {
+		return closed, nil
+	}
+
+	for _, c := range closed {
+		c.VoterIDs, err = pollVoters(ctx, tx, c.TweetID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = insertOutbox(ctx, tx, c.TweetID, events(c))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE polls SET closed_at=now() WHERE tweet_id = ANY($1::int[])", pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("Could not close polls: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("Could not commit transaction: %v", err)
+	}
+	return closed, nil
+}
+
+// pollVoters return the users who voted in a poll.
+func pollVoters(ctx context.Context, q queryer, tweetID int64) ([]int64, error) {
+	rows, err := q.QueryContext(ctx, "SELECT user_id FROM poll_votes WHERE tweet_id=$1", tweetID)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get voters: %v", err)
+	}
+	defer rows.Close()
+
+	voters := []int64{}
+	for rows.Next() {
+		var id int64
+		err := rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("Could not scan voter: %v", err)
+		}
+		voters = append(voters, id)
+	}
+	return voters, rows.Err()
+}
+
+// insertPoll create a tweet poll.
+func insertPoll(ctx context.Context, tx *sql.Tx, tweetID int64, poll *pb.Poll) error {
+	if poll == nil {
+		return nil
+	}
+
+	closesAt, err := ptypes.Timestamp(poll.ClosesAt)
+	if err != nil {
+		return fmt.Errorf("Invalid poll closing time: %v", err)
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO polls (tweet_id, closes_at) VALUES ($1, $2)", tweetID, closesAt)
+	if err != nil {
+		return fmt.Errorf("Could not create poll: %v", err)
+	}
+
+	for i, o := range poll.Options {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO poll_options (tweet_id, position, text) VALUES ($1, $2, $3)",
+			tweetID, i, o.Text,
+		)
+		if err != nil {
+			return fmt.Errorf("Could not create poll option: %v", err)
+		}
+	}
+	return nil
+}
+
+// hydratePolls set tweets polls, counts are hidden from viewers who did
+// not vote in an open poll of another author.
+func hydratePolls(ctx context.Context, q queryer, viewerID int64, tweets []*pb.Tweet) error {
+	if len(tweets) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*pb.Tweet, len(tweets))
+	ids := make([]int64, 0, len(tweets))
+	for _, t := range tweets {
+		byID[t.Id] = t
+		ids = append(ids, t.Id)
+	}
+
+	rows, err := q.QueryContext(
+		ctx, `
+		SELECT p.tweet_id, p.closes_at, p.closes_at <= now(), o.position, o.text, o.votes
+		FROM polls p JOIN poll_options o ON o.tweet_id = p.tweet_id
+		WHERE p.tweet_id = ANY($1::int[])
+		ORDER BY p.tweet_id, o.position`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		return fmt.Errorf("Could not get polls: %v", err)
+	}
+	defer rows.Close()
+
+	polls := map[int64]*pb.Poll{}
+	for rows.Next() {
+		var tweetID int64
+		var closesAt time.Time
+		var closed bool
+		o := &pb.PollOption{}
+
+		err := rows.Scan(&tweetID, &closesAt, &closed, &o.Position, &o.Text, &o.Votes)
+		if err != nil {
+			return fmt.Errorf("Could not scan poll: %v", err)
+		}
+
+		poll, ok := polls[tweetID]
+		if !ok {
+			poll = &pb.Poll{Closed: closed}
+			poll.ClosesAt, _ = ptypes.TimestampProto(closesAt)
+			polls[tweetID] = poll
+			byID[tweetID].Poll = poll
+		}
+		poll.Options = append(poll.Options, o)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("Could not iterate polls: %v", err)
+	}
+	rows.Close()
+
+	if len(polls) == 0 {
+		return nil
+	}
+
+	if viewerID != 0 {
+		err = hydrateVotes(ctx, q, viewerID, polls)
+		if err != nil {
+			return err
+		}
+	}
+
+	viewer := strconv.FormatInt(viewerID, 10)
+	for tweetID, poll := range polls {
+		poll.ResultsVisible = poll.Closed || poll.Voted || byID[tweetID].UserId == viewer
+		for _, o := range poll.Options {
+			if !poll.ResultsVisible {
+				o.Votes = 0
+			}
+			poll.TotalVotes += o.Votes
+		}
+	}
+	return nil
+}
+
+// hydrateVotes set the viewer votes.
+func hydrateVotes(ctx context.Context, q queryer, viewerID int64, polls map[int64]*pb.Poll) error {
+	ids := make([]int64, 0, len(polls))
+	for id := range polls {
+		ids = append(ids, id)
+	}
+
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT tweet_id, position FROM poll_votes WHERE user_id=$1 AND tweet_id = ANY($2::int[])",
+		viewerID, pq.Array(ids),
+	)
+	if err != nil {
+		return fmt.Errorf("Could not get votes: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tweetID int64
+		var position int32
+		err := rows.Scan(&tweetID, &position)
+		if err != nil {
+			return fmt.Errorf("Could not scan vote: %v", err)
+		}
+		polls[tweetID].Voted = true
+		polls[tweetID].VotedOption = position
+	}
+	return rows.Err()
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
 
 	"github.com/idirall22/twee/common"
 	option "github.com/idirall22/twee/options"
 	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
 	"go.uber.org/zap"
 )
 
@@ -35,122 +38,461 @@ func NewPostgresTweetStore(opts *option.PostgresOptions) (*PostgresTweetStore, e
 	}, nil
 }
 
-// Create tweet
-func (p *PostgresTweetStore) Create(ctx context.Context, userID int64, content string) (int64, error) {
-	query := fmt.Sprintf(`
-		INSERT INTO tweets (content, user_id)
-		VALUES ('%s', '%d')
+// Create tweet and write its events to the outbox.
+func (p *PostgresTweetStore) Create(ctx context.Context, userID int64, tweet *pb.Tweet, events ...*pb.TweetEvent) (int64, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	id, err := createTweet(ctx, tx, userID, tweet)
+	if err != nil {
+		return 0, err
+	}
+
+	err = insertOutbox(ctx, tx, id, events)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("Could not commit transaction: %v", err)
+	}
+
+	return id, nil
+}
+
+// createTweet insert a tweet and its entities.
+func createTweet(ctx context.Context, tx *sql.Tx, userID int64, tweet *pb.Tweet) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(
+		ctx, `
+		INSERT INTO tweets (content, user_id, in_reply_to_tweet_id, conversation_id, quoted_tweet_id,
+			visibility, reply_policy)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING  id`,
-		content, userID,
-	)
+		tweet.Content,
+		userID,
+		nullID(tweet.InReplyToTweetId),
+		nullID(tweet.ConversationId),
+		nullID(tweet.QuotedTweetId),
+		tweet.Visibility.String(),
+		tweet.ReplyPolicy.String(),
+	).Scan(&id)
+
+	if err != nil {
+		return 0, fmt.Errorf("Could not create a record: %v", err)
+	}
+
+	err = insertEntities(ctx, tx, id, tweet.Entities)
+	if err != nil {
+		return 0, err
+	}
+
+	err = insertMedia(ctx, tx, id, tweet.Media)
+	if err != nil {
+		return 0, err
+	}
+
+	err = insertPoll(ctx, tx, id, tweet.Poll)
+	if err != nil {
+		return 0, err
+	}
+
+	// a tweet that is not a reply starts its own conversation.
+	if tweet.ConversationId == 0 {
+		_, err = tx.ExecContext(ctx, "UPDATE tweets SET conversation_id=id WHERE id=$1", id)
+		if err != nil {
+			return 0, fmt.Errorf("Could not set conversation: %v", err)
+		}
+	}
+
+	return id, nil
+}
+
+// Retweet create a retweet of the original tweet.
+func (p *PostgresTweetStore) Retweet(ctx context.Context, userID int64, originalID int64, events ...*pb.TweetEvent) (int64, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// lock the original to keep the retweet count consistent.
+	var exists bool
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT true FROM tweets WHERE id=$1 AND deleted_at IS NULL AND retweet_of_id IS NULL FOR UPDATE",
+		originalID,
+	).Scan(&exists)
+
+	if err == sql.ErrNoRows {
+		return 0, utils.ErrNotExists
+	}
+	if err != nil {
+		return 0, fmt.Errorf("Could not get original tweet: %v", err)
+	}
+
 	var id int64
-	err := p.db.QueryRowContext(ctx, query).Scan(&id)
+	err = tx.QueryRowContext(
+		ctx, `
+		INSERT INTO tweets (content, user_id, retweet_of_id)
+		VALUES ('', $1, $2)
+		ON CONFLICT (user_id, retweet_of_id) WHERE retweet_of_id IS NOT NULL DO NOTHING
+		RETURNING id`,
+		userID, originalID,
+	).Scan(&id)
 
+	if err == sql.ErrNoRows {
+		return 0, utils.ErrAlreadyExists
+	}
 	if err != nil {
 		return 0, fmt.Errorf("Could not create a record: %v", err)
 	}
 
+	_, err = tx.ExecContext(ctx, "UPDATE tweets SET retweet_count=retweet_count+1 WHERE id=$1", originalID)
+	if err != nil {
+		return 0, fmt.Errorf("Could not update retweet count: %v", err)
+	}
+
+	err = insertOutbox(ctx, tx, id, events)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("Could not commit transaction: %v", err)
+	}
+
 	return id, nil
 }
 
-// Update tweet
-func (p *PostgresTweetStore) Update(ctx context.Context, userID int64, id int64, content string) error {
+// UndoRetweet delete the user retweet of the original tweet, the events
+// receive the retweet id and the original author.
+func (p *PostgresTweetStore) UndoRetweet(ctx context.Context, userID int64, originalID int64,
+	events ...*pb.TweetEvent) error {
 
 	tx, err := p.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("Could not init a transaction: %v", err)
 	}
+	defer tx.Rollback()
 
-	var exists bool
-	stmt, err := tx.PrepareContext(
+	id, originalUserID, err := undoRetweet(ctx, tx, userID, originalID)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		if e.TweetUserId == 0 {
+			e.TweetUserId = originalUserID
+		}
+	}
+
+	err = insertOutbox(ctx, tx, id, events)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Could not commit transaction: %v", err)
+	}
+	return nil
+}
+
+// undoRetweet delete the user retweet of the original tweet, it return the
+// retweet id and the original author id.
+func undoRetweet(ctx context.Context, tx *sql.Tx, userID int64, originalID int64) (int64, int64, error) {
+	var id int64
+	err := tx.QueryRowContext(
 		ctx,
-		"SELECT EXISTS(SELECT 1 FROM tweets WHERE id=$1)",
-	)
+		"DELETE FROM tweets WHERE user_id=$1 AND retweet_of_id=$2 RETURNING id",
+		userID, originalID,
+	).Scan(&id)
+
+	if err == sql.ErrNoRows {
+		return 0, 0, utils.ErrNotExists
+	}
 	if err != nil {
-		tx.Rollback()
-		return fmt.Errorf("Could not prepare a statment: %v", err)
+		return 0, 0, fmt.Errorf("Could not delete a record: %v", err)
 	}
 
-	err = stmt.QueryRowContext(ctx, id).Scan(&exists)
+	var originalUserID int64
+	err = tx.QueryRowContext(
+		ctx,
+		"UPDATE tweets SET retweet_count=GREATEST(retweet_count-1, 0) WHERE id=$1 RETURNING user_id",
+		originalID,
+	).Scan(&originalUserID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Could not update retweet count: %v", err)
+	}
+	return id, originalUserID, nil
+}
 
-	if err != sql.ErrNoRows && !exists {
-		tx.Rollback()
-		return fmt.Errorf("Record not exists: %v", err)
+// Update tweet content, the previous content is kept as a revision.
+func (p *PostgresTweetStore) Update(ctx context.Context, userID int64, id int64, content string,
+	entities []*pb.TweetEntity, events ...*pb.TweetEvent) error {
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not init a transaction: %v", err)
 	}
+	defer tx.Rollback()
+
+	var oldContent string
+	var createdAt time.Time
+	var editedAt sql.NullTime
+	var revisionCount int
+	err = tx.QueryRowContext(
+		ctx, `
+		SELECT content, created_at, edited_at, revision_count FROM tweets
+		WHERE id=$1 AND user_id=$2 AND deleted_at IS NULL AND retweet_of_id IS NULL
+		FOR UPDATE`,
+		id, userID,
+	).Scan(&oldContent, &createdAt, &editedAt, &revisionCount)
 
+	if err == sql.ErrNoRows {
+		return utils.ErrNotExists
+	}
 	if err != nil {
 		return fmt.Errorf("Could not get tweet infos: %v", err)
 	}
 
-	stmt, err = tx.PrepareContext(ctx, `UPDATE tweets SET content=$1 WHERE id=$2`)
+	// the previous revision was published at the last edit.
+	publishedAt := createdAt
+	if editedAt.Valid {
+		publishedAt = editedAt.Time
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO tweet_revisions (tweet_id, revision, content, created_at) VALUES ($1, $2, $3, $4)",
+		id, revisionCount, oldContent, publishedAt,
+	)
 	if err != nil {
-		tx.Rollback()
-		return fmt.Errorf("Could not prepare a statment: %v", err)
+		return fmt.Errorf("Could not create revision: %v", err)
 	}
 
-	_, err = stmt.ExecContext(ctx, content, id)
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE tweets SET content=$1, edited_at=now(), revision_count=revision_count+1 WHERE id=$2",
+		content, id,
+	)
 	if err != nil {
-		tx.Rollback()
-		return fmt.Errorf("Could not create a record: %v", err)
+		return fmt.Errorf("Could not update a record: %v", err)
+	}
+
+	err = replaceEntities(ctx, tx, id, entities)
+	if err != nil {
+		return err
+	}
+
+	err = insertOutbox(ctx, tx, id, events)
+	if err != nil {
+		return err
 	}
 
 	err = tx.Commit()
 	if err != nil {
-		tx.Rollback()
 		return fmt.Errorf("Could not commit transaction: %v", err)
 	}
 	return nil
 }
 
-// Delete tweet
-func (p *PostgresTweetStore) Delete(ctx context.Context, userID int64, id int64) error {
+// ListRevisions list a tweet contents oldest first, the last revision
+// is the current content.
+func (p *PostgresTweetStore) ListRevisions(ctx context.Context, viewerID, tweetID int64) ([]*pb.TweetRevision, error) {
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	current := &pb.TweetRevision{}
+	var publishedAt time.Time
+	err = tx.QueryRowContext(
+		ctx, `
+		SELECT revision_count, content, COALESCE(edited_at, created_at) FROM tweets
+		WHERE id=$1 AND deleted_at IS NULL AND `+common.TweetVisibleTo("tweets", "$2"),
+		tweetID, viewerID,
+	).Scan(&current.Revision, &current.Content, &publishedAt)
 
-	tx, err := p.db.BeginTx(ctx, nil)
+	if err == sql.ErrNoRows {
+		return nil, utils.ErrNotExists
+	}
 	if err != nil {
-		return fmt.Errorf("Could not init a transaction: %v", err)
+		return nil, fmt.Errorf("Could not get tweet infos: %v", err)
 	}
+	current.CreatedAt, _ = ptypes.TimestampProto(publishedAt)
 
-	var exists bool
-	stmt, err := tx.PrepareContext(ctx, `SELECT EXISTS(SELECT 1 FROM tweets WHERE id=$1)`)
+	rows, err := tx.QueryContext(
+		ctx,
+		"SELECT revision, content, created_at FROM tweet_revisions WHERE tweet_id=$1 ORDER BY revision",
+		tweetID,
+	)
 	if err != nil {
-		tx.Rollback()
-		return fmt.Errorf("Could not prepare a statment: %v", err)
+		return nil, fmt.Errorf("Could not get revisions: %v", err)
 	}
+	defer rows.Close()
+
+	revisions := []*pb.TweetRevision{}
+	for rows.Next() {
+		r := &pb.TweetRevision{}
+		err := rows.Scan(&r.Revision, &r.Content, &publishedAt)
+		if err != nil {
+			return nil, fmt.Errorf("Could not scan revision: %v", err)
+		}
+		r.CreatedAt, _ = ptypes.TimestampProto(publishedAt)
+		revisions = append(revisions, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Could not iterate revisions: %v", err)
+	}
+
+	return append(revisions, current), nil
+}
 
-	err = stmt.QueryRowContext(ctx, id).Scan(&exists)
+// Delete tweet, a retweet is removed while other tweets are kept as
+// tombstones so retweets, quotes and replies can still reference them.
+func (p *PostgresTweetStore) Delete(ctx context.Context, userID int64, id int64, events ...*pb.TweetEvent) error {
 
-	if !exists {
-		return fmt.Errorf("Record not exists")
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not init a transaction: %v", err)
 	}
+	defer tx.Rollback()
+
+	var retweetOf sql.NullInt64
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT retweet_of_id FROM tweets WHERE id=$1 AND user_id=$2 AND deleted_at IS NULL FOR UPDATE",
+		id, userID,
+	).Scan(&retweetOf)
 
 	if err == sql.ErrNoRows {
 		p.logger.Info("Could not delete a tweet, Record Not exists")
-		tx.Rollback()
-		return fmt.Errorf("Record not exists: %v", err)
+		return utils.ErrNotExists
 	}
 
 	if err != nil {
 		return fmt.Errorf("Could not get tweet infos: %v", err)
 	}
 
-	stmt, err = tx.PrepareContext(ctx, `DELETE FROM tweets WHERE id=$1`)
+	if retweetOf.Valid {
+		_, _, err = undoRetweet(ctx, tx, userID, retweetOf.Int64)
+	} else {
+		_, err = tx.ExecContext(
+			ctx,
+			"UPDATE tweets SET content='', deleted_at=now() WHERE id=$1",
+			id,
+		)
+	}
 	if err != nil {
-		tx.Rollback()
-		return fmt.Errorf("Could not prepare delete statment: %v", err)
+		return fmt.Errorf("Could not delete a record: %v", err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE users SET pinned_tweet_id=NULL WHERE id=$1 AND pinned_tweet_id=$2",
+		userID, id,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not unpin tweet: %v", err)
 	}
 
-	_, err = stmt.ExecContext(ctx, id)
+	err = insertOutbox(ctx, tx, id, events)
 	if err != nil {
-		tx.Rollback()
-		return fmt.Errorf("Could not delete a record: %v", err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Could not commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// Pin a user tweet, retweets and deleted tweets can not be pinned.
+func (p *PostgresTweetStore) Pin(ctx context.Context, userID int64, tweetID int64, events ...*pb.TweetEvent) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(
+		ctx, `
+		UPDATE users SET pinned_tweet_id=$2
+		WHERE id=$1 AND EXISTS(
+			SELECT 1 FROM tweets
+			WHERE id=$2 AND user_id=$1 AND deleted_at IS NULL AND retweet_of_id IS NULL
+		)`,
+		userID, tweetID,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not pin tweet: %v", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("Could not get affected rows: %v", err)
+	}
+	if count == 0 {
+		return utils.ErrNotExists
+	}
+
+	err = insertOutbox(ctx, tx, tweetID, events)
+	if err != nil {
+		return err
 	}
 
 	err = tx.Commit()
 	if err != nil {
-		tx.Rollback()
 		return fmt.Errorf("Could not commit transaction: %v", err)
 	}
+	return nil
+}
+
+// Unpin the user pinned tweet.
+func (p *PostgresTweetStore) Unpin(ctx context.Context, userID int64, events ...*pb.TweetEvent) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var pinned sql.NullInt64
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT pinned_tweet_id FROM users WHERE id=$1 FOR UPDATE",
+		userID,
+	).Scan(&pinned)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("Could not get pinned tweet: %v", err)
+	}
+	if !pinned.Valid {
+		return utils.ErrNotExists
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE users SET pinned_tweet_id=NULL WHERE id=$1", userID)
+	if err != nil {
+		return fmt.Errorf("Could not unpin tweet: %v", err)
+	}
 
+	err = insertOutbox(ctx, tx, pinned.Int64, events)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Could not commit transaction: %v", err)
+	}
 	return nil
 }
 
@@ -164,22 +506,19 @@ func (p *PostgresTweetStore) Get(ctx context.Context, userID int64, id int64) (*
 
 	stmt, err := tx.PrepareContext(
 		ctx,
-		"SELECT user_id, content, created_at FROM tweets WHERE id=$1",
+		"SELECT "+tweetColumns+" FROM tweets WHERE id=$1 AND deleted_at IS NULL AND "+
+			common.TweetVisibleTo("tweets", "$2"),
 	)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("Could not prepare a statment: %v", err)
+	}
 
-	tweet := &pb.Tweet{}
-	var t time.Time
-
-	err = stmt.QueryRowContext(ctx, id).Scan(
-		&tweet.UserId,
-		&tweet.Content,
-		&t,
-	)
-	tweet.CreatedAt, _ = ptypes.TimestampProto(t)
+	tweet, err := scanTweet(stmt.QueryRowContext(ctx, id, userID))
 
 	if err == sql.ErrNoRows {
 		tx.Rollback()
-		return nil, fmt.Errorf("Record not exists: %v", err)
+		return nil, utils.ErrNotExists
 	}
 
 	if err != nil {
@@ -187,6 +526,12 @@ func (p *PostgresTweetStore) Get(ctx context.Context, userID int64, id int64) (*
 		return nil, fmt.Errorf("Could not get tweet: %v", err)
 	}
 
+	err = hydrate(ctx, tx, userID, []*pb.Tweet{tweet})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
@@ -196,53 +541,349 @@ func (p *PostgresTweetStore) Get(ctx context.Context, userID int64, id int64) (*
 	return tweet, nil
 }
 
-// List tweets
-func (p *PostgresTweetStore) List(ctx context.Context, userID int64, page int) ([]*pb.Tweet, error) {
+// List user tweets newest first.
+func (p *PostgresTweetStore) List(ctx context.Context, viewerID, userID int64, page *common.Page) ([]*pb.Tweet, error) {
+	args := []interface{}{userID, viewerID}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conds := append([]string{
+		"tweets.user_id=$1",
+		"tweets.deleted_at IS NULL",
+		common.TweetVisibleTo("tweets", "$2"),
+	}, page.Conditions("tweets", arg)...)
 
-	tx, err := p.db.BeginTx(ctx, nil)
+	query := "SELECT " + tweetColumns + " FROM tweets WHERE " + strings.Join(conds, " AND ") +
+		" ORDER BY created_at DESC, id DESC LIMIT " + arg(page.Limit)
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("Could not init a transaction: %v", err)
+		return nil, fmt.Errorf("Could not get tweets: %v", err)
 	}
+	defer rows.Close()
 
-	stmt, err := tx.PrepareContext(
+	tweets, err := scanTweets(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	err = hydrate(ctx, p.db, viewerID, tweets)
+	if err != nil {
+		return nil, err
+	}
+	return tweets, nil
+}
+
+// Conversation list conversation tweets ordered by creation date, tweets
+// hidden from the viewer are skipped.
+func (p *PostgresTweetStore) Conversation(ctx context.Context, viewerID, conversationID int64) ([]*pb.Tweet, error) {
+	rows, err := p.db.QueryContext(
 		ctx,
-		"SELECT id, user_id, content, created_at FROM tweets WHERE user_id=$1 LIMIT 10 OFFSET $2",
+		"SELECT "+tweetColumns+" FROM tweets WHERE conversation_id=$1 AND "+
+			common.TweetVisibleTo("tweets", "$2")+" ORDER BY created_at, id",
+		conversationID, viewerID,
 	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get conversation tweets: %v", err)
+	}
+	defer rows.Close()
 
-	tweets := []*pb.Tweet{}
-	rows, err := stmt.QueryContext(ctx, userID, page)
+	tweets, err := scanTweets(rows)
+	if err != nil {
+		return nil, err
+	}
 
+	err = hydrate(ctx, p.db, viewerID, tweets)
 	if err != nil {
-		tx.Rollback()
-		return nil, fmt.Errorf("Could not get tweets")
+		return nil, err
+	}
+	return tweets, nil
+}
+
+// GetMany get tweets by id in the ids order, deleted, missing and hidden
+// tweets are skipped.
+func (p *PostgresTweetStore) GetMany(ctx context.Context, viewerID int64, ids []int64) ([]*pb.Tweet, error) {
+	rows, err := p.db.QueryContext(
+		ctx,
+		"SELECT "+tweetColumns+" FROM tweets WHERE id = ANY($1::int[]) AND deleted_at IS NULL AND "+
+			common.TweetVisibleTo("tweets", "$2"),
+		pq.Array(ids), viewerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get tweets: %v", err)
 	}
 	defer rows.Close()
 
+	found, err := scanTweets(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int64]*pb.Tweet, len(found))
+	for _, t := range found {
+		byID[t.Id] = t
+	}
+
+	tweets := make([]*pb.Tweet, 0, len(found))
+	for _, id := range ids {
+		if t, ok := byID[id]; ok {
+			tweets = append(tweets, t)
+		}
+	}
+
+	err = hydrate(ctx, p.db, viewerID, tweets)
+	if err != nil {
+		return nil, err
+	}
+	return tweets, nil
+}
+
+// tweetColumns columns selected to build a tweet, see scanTweet.
+const tweetColumns = `id, user_id, content, created_at, in_reply_to_tweet_id, conversation_id,
+	retweet_of_id, quoted_tweet_id, retweet_count, deleted_at IS NOT NULL, like_count,
+	edited_at, revision_count, visibility, reply_policy,
+	EXISTS(SELECT 1 FROM users pu WHERE pu.pinned_tweet_id = tweets.id)`
+
+// queryer is implemented by sql.DB and sql.Tx.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// hydrate set tweets fields that are not stored in the tweets table.
+func hydrate(ctx context.Context, q queryer, viewerID int64, tweets []*pb.Tweet) error {
+	err := hydrateOriginals(ctx, q, viewerID, tweets)
+	if err != nil {
+		return err
+	}
+
+	err = hydrateEntities(ctx, q, tweets)
+	if err != nil {
+		return err
+	}
+
+	err = hydrateMedia(ctx, q, tweets)
+	if err != nil {
+		return err
+	}
+
+	err = hydratePolls(ctx, q, viewerID, tweets)
+	if err != nil {
+		return err
+	}
+
+	err = hydrateReplyPermissions(ctx, q, viewerID, tweets)
+	if err != nil {
+		return err
+	}
+
+	err = hydrateBookmarks(ctx, q, viewerID, tweets)
+	if err != nil {
+		return err
+	}
+	return hydrateLikes(ctx, q, viewerID, tweets)
+}
+
+// HydrateAttachments set the media and polls of tweets listed by other
+// stores and of their originals, poll results as seen by the viewer.
+func HydrateAttachments(ctx context.Context, q queryer, viewerID int64, tweets []*pb.Tweet) error {
+	// a tweet may be listed and quoted in the same page.
+	byID := map[int64][]*pb.Tweet{}
+	unique := []*pb.Tweet{}
+	for _, t := range tweets {
+		for _, tweet := range []*pb.Tweet{t, t.Original} {
+			if tweet == nil || tweet.Deleted {
+				continue
+			}
+			if _, ok := byID[tweet.Id]; !ok {
+				unique = append(unique, tweet)
+			}
+			byID[tweet.Id] = append(byID[tweet.Id], tweet)
+		}
+	}
+
+	err := hydrateMedia(ctx, q, unique)
+	if err != nil {
+		return err
+	}
+
+	err = hydratePolls(ctx, q, viewerID, unique)
+	if err != nil {
+		return err
+	}
+
+	for _, same := range byID {
+		for _, tweet := range same[1:] {
+			tweet.Media = same[0].Media
+			tweet.Poll = same[0].Poll
+		}
+	}
+	return nil
+}
+
+// hydrateOriginals set the original of retweets and quotes, deleted
+// originals and originals hidden from the viewer are returned as tombstones.
+func hydrateOriginals(ctx context.Context, q queryer, viewerID int64, tweets []*pb.Tweet) error {
+	ids := []int64{}
+	for _, t := range tweets {
+		if id := originalID(t); id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT "+tweetColumns+" FROM tweets WHERE id = ANY($1::int[]) AND "+
+			common.TweetVisibleTo("tweets", "$2"),
+		pq.Array(ids), viewerID,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not get original tweets: %v", err)
+	}
+	defer rows.Close()
+
+	originals, err := scanTweets(rows)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[int64]*pb.Tweet, len(originals))
+	for _, o := range originals {
+		byID[o.Id] = o
+	}
+
+	for _, t := range tweets {
+		id := originalID(t)
+		if id == 0 {
+			continue
+		}
+		t.Original = byID[id]
+		if t.Original == nil {
+			t.Original = &pb.Tweet{Id: id, Deleted: true}
+		}
+	}
+	return nil
+}
+
+// hydrateReplyPermissions set whether the viewer can reply to each tweet.
+func hydrateReplyPermissions(ctx context.Context, q queryer, viewerID int64, tweets []*pb.Tweet) error {
+	ids := make([]int64, 0, len(tweets))
+	for _, t := range tweets {
+		if !t.Deleted {
+			ids = append(ids, t.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT id FROM tweets WHERE id = ANY($1::int[]) AND "+common.TweetRepliableBy("tweets", "$2"),
+		pq.Array(ids), viewerID,
+	)
+	if err != nil {
+		return fmt.Errorf("Could not get reply permissions: %v", err)
+	}
+	defer rows.Close()
+
+	allowed := make(map[int64]bool, len(ids))
 	for rows.Next() {
-		tweet := &pb.Tweet{}
-		var t time.Time
-
-		err = rows.Scan(
-			&tweet.Id,
-			&tweet.UserId,
-			&tweet.Content,
-			&t,
-		)
-		tweet.CreatedAt, _ = ptypes.TimestampProto(t)
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return fmt.Errorf("Could not scan reply permission: %v", err)
+		}
+		allowed[id] = true
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("Could not iterate reply permissions: %v", err)
+	}
+
+	for _, t := range tweets {
+		t.CanReply = allowed[t.Id]
+	}
+	return nil
+}
+
+// originalID return the retweeted or quoted tweet id.
+func originalID(t *pb.Tweet) int64 {
+	if t.RetweetOfId != 0 {
+		return t.RetweetOfId
+	}
+	return t.QuotedTweetId
+}
+
+// nullID convert a zero id to NULL.
+func nullID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
+
+// rowScanner is implemented by sql.Row and sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
 
+// scanTweet scan a row selected with tweetColumns.
+func scanTweet(row rowScanner) (*pb.Tweet, error) {
+	tweet := &pb.Tweet{}
+	var t time.Time
+	var inReplyTo, conversationID, retweetOf, quoted sql.NullInt64
+	var editedAt sql.NullTime
+	var visibility, replyPolicy string
+
+	err := row.Scan(
+		&tweet.Id,
+		&tweet.UserId,
+		&tweet.Content,
+		&t,
+		&inReplyTo,
+		&conversationID,
+		&retweetOf,
+		&quoted,
+		&tweet.RetweetCount,
+		&tweet.Deleted,
+		&tweet.LikeCount,
+		&editedAt,
+		&tweet.RevisionCount,
+		&visibility,
+		&replyPolicy,
+		&tweet.Pinned,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tweet.CreatedAt, _ = ptypes.TimestampProto(t)
+	tweet.InReplyToTweetId = inReplyTo.Int64
+	tweet.ConversationId = conversationID.Int64
+	tweet.RetweetOfId = retweetOf.Int64
+	tweet.QuotedTweetId = quoted.Int64
+	if editedAt.Valid {
+		tweet.EditedAt, _ = ptypes.TimestampProto(editedAt.Time)
+	}
+	tweet.Visibility = pb.Visibility(pb.Visibility_value[visibility])
+	tweet.ReplyPolicy = pb.ReplyPolicy(pb.ReplyPolicy_value[replyPolicy])
+	return tweet, nil
+}
+
+// scanTweets scan all rows selected with tweetColumns.
+func scanTweets(rows *sql.Rows) ([]*pb.Tweet, error) {
+	tweets := []*pb.Tweet{}
+	for rows.Next() {
+		tweet, err := scanTweet(rows)
 		if err != nil {
-			tx.Rollback()
 			return nil, fmt.Errorf("Could not scan: %v", err)
 		}
 		tweets = append(tweets, tweet)
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		tx.Rollback()
-		return nil, fmt.Errorf("Could not commit transaction: %v", err)
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Could not iterate tweets: %v", err)
 	}
-
 	return tweets, nil
 }
 
@@ -0,0 +1,283 @@
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/store"
+	"github.com/idirall22/twee/utils"
+)
+
+// scheduledColumns columns selected to build a scheduled tweet, see scanScheduled.
+const scheduledColumns = `s.id, s.user_id, u.username, s.content, s.reply_to, s.quote_tweet_id,
+	s.publish_at, s.created_at, s.updated_at, s.error`
+
+// CreateScheduled save a draft or a scheduled tweet.
+func (p *PostgresTweetStore) CreateScheduled(ctx context.Context, userID int64, st *pb.ScheduledTweet) (*pb.ScheduledTweet, error) {
+	publishAt, err := nullTimestamp(st)
+	if err != nil {
+		return nil, err
+	}
+
+	var id int64
+	err = p.db.QueryRowContext(
+		ctx, `
+		INSERT INTO scheduled_tweets (user_id, content, reply_to, quote_tweet_id, publish_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`,
+		userID, st.Content, nullID(st.ReplyTo), nullID(st.QuoteTweetId), publishAt,
+	).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("Could not create a record: %v", err)
+	}
+
+	return p.getScheduled(ctx, userID, id)
+}
+
+// ListScheduled list a user drafts or scheduled tweets ordered by
+// publication date for scheduled tweets and last update for drafts.
+func (p *PostgresTweetStore) ListScheduled(ctx context.Context, userID int64, drafts bool) ([]*pb.ScheduledTweet, error) {
+	query := "SELECT " + scheduledColumns + `
+		FROM scheduled_tweets s JOIN users u ON u.id = s.user_id
+		WHERE s.user_id=$1 AND s.publish_at IS NOT NULL ORDER BY s.publish_at, s.id`
+	if drafts {
+		query = "SELECT " + scheduledColumns + `
+		FROM scheduled_tweets s JOIN users u ON u.id = s.user_id
+		WHERE s.user_id=$1 AND s.publish_at IS NULL ORDER BY s.updated_at DESC, s.id DESC`
+	}
+
+	rows, err := p.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("Could not list scheduled tweets: %v", err)
+	}
+	defer rows.Close()
+
+	list := []*pb.ScheduledTweet{}
+	for rows.Next() {
+		st, err := scanScheduled(rows)
+		if err != nil {
+			return nil, fmt.Errorf("Could not scan: %v", err)
+		}
+		list = append(list, st)
+	}
+
+	return list, rows.Err()
+}
+
+// UpdateScheduled edit the content and publication date of a scheduled tweet,
+// the content is kept when empty.
+func (p *PostgresTweetStore) UpdateScheduled(ctx context.Context, userID int64, st *pb.ScheduledTweet) (*pb.ScheduledTweet, error) {
+	publishAt, err := nullTimestamp(st)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.db.ExecContext(
+		ctx, `
+		UPDATE scheduled_tweets
+		SET content=COALESCE(NULLIF($1, ''), content), publish_at=$2, error='', updated_at=now()
+		WHERE id=$3 AND user_id=$4`,
+		st.Content, publishAt, st.Id, userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not update a record: %v", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("Could not update a record: %v", err)
+	}
+	if n == 0 {
+		return nil, utils.ErrNotExists
+	}
+
+	return p.getScheduled(ctx, userID, st.Id)
+}
+
+// DeleteScheduled delete a draft or cancel a scheduled tweet.
+func (p *PostgresTweetStore) DeleteScheduled(ctx context.Context, userID int64, id int64) error {
+	res, err := p.db.ExecContext(ctx, "DELETE FROM scheduled_tweets WHERE id=$1 AND user_id=$2", id, userID)
+	if err != nil {
+		return fmt.Errorf("Could not delete a record: %v", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("Could not delete a record: %v", err)
+	}
+	if n == 0 {
+		return utils.ErrNotExists
+	}
+	return nil
+}
+
+// PublishDue create the tweets of at most limit due scheduled tweets.
+// Due rows are locked with SKIP LOCKED and removed in the transaction
+// creating their tweet, so concurrent schedulers publish each tweet once.
+// Scheduled tweets that could not be built or created become drafts with
+// an error, each one in its own savepoint so they do not block the batch.
+func (p *PostgresTweetStore) PublishDue(ctx context.Context, limit int, build store.ScheduledBuilder) ([]*store.PublishedTweet, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not init a transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(
+		ctx, "SELECT "+scheduledColumns+`
+		FROM scheduled_tweets s JOIN users u ON u.id = s.user_id
+		WHERE s.publish_at <= now()
+		ORDER BY s.publish_at, s.id
+		LIMIT $1
+		FOR UPDATE OF s SKIP LOCKED`,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get due tweets: %v", err)
+	}
+
+	due := []*pb.ScheduledTweet{}
+	for rows.Next() {
+		st, err := scanScheduled(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("Could not scan: %v", err)
+		}
+		due = append(due, st)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Could not iterate due tweets: %v", err)
+	}
+
+	published := []*store.PublishedTweet{}
+	for _, st := range due {
+		_, err = tx.ExecContext(ctx, "SAVEPOINT publish_due")
+		if err != nil {
+			return nil, fmt.Errorf("Could not create savepoint: %v", err)
+		}
+
+		pt, publishErr := build(st)
+		if publishErr == nil {
+			publishErr = publishScheduled(ctx, tx, st, pt)
+		}
+
+		if publishErr != nil {
+			_, err = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT publish_due")
+			if err != nil {
+				return nil, fmt.Errorf("Could not rollback to savepoint: %v", err)
+			}
+
+			_, err = tx.ExecContext(
+				ctx,
+				"UPDATE scheduled_tweets SET publish_at=NULL, error=$1, updated_at=now() WHERE id=$2",
+				publishErr.Error(), st.Id,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("Could not save publication error: %v", err)
+			}
+			continue
+		}
+
+		_, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT publish_due")
+		if err != nil {
+			return nil, fmt.Errorf("Could not release savepoint: %v", err)
+		}
+		published = append(published, pt)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("Could not commit transaction: %v", err)
+	}
+	return published, nil
+}
+
+// publishScheduled create the tweet of a due scheduled tweet and remove it.
+func publishScheduled(ctx context.Context, tx *sql.Tx, st *pb.ScheduledTweet, pt *store.PublishedTweet) error {
+	var err error
+	pt.Tweet.Id, err = createTweet(ctx, tx, st.UserId, pt.Tweet)
+	if err != nil {
+		return err
+	}
+
+	err = insertOutbox(ctx, tx, pt.Tweet.Id, pt.Events)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM scheduled_tweets WHERE id=$1", st.Id)
+	if err != nil {
+		return fmt.Errorf("Could not delete a record: %v", err)
+	}
+	return nil
+}
+
+// getScheduled get a user scheduled tweet.
+func (p *PostgresTweetStore) getScheduled(ctx context.Context, userID, id int64) (*pb.ScheduledTweet, error) {
+	st, err := scanScheduled(p.db.QueryRowContext(
+		ctx, "SELECT "+scheduledColumns+`
+		FROM scheduled_tweets s JOIN users u ON u.id = s.user_id
+		WHERE s.id=$1 AND s.user_id=$2`,
+		id, userID,
+	))
+	if err == sql.ErrNoRows {
+		return nil, utils.ErrNotExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not get scheduled tweet: %v", err)
+	}
+	return st, nil
+}
+
+// scanScheduled scan a row selected with scheduledColumns.
+func scanScheduled(row rowScanner) (*pb.ScheduledTweet, error) {
+	st := &pb.ScheduledTweet{}
+	var replyTo, quoted sql.NullInt64
+	var publishAt sql.NullTime
+	var createdAt, updatedAt time.Time
+
+	err := row.Scan(
+		&st.Id,
+		&st.UserId,
+		&st.Username,
+		&st.Content,
+		&replyTo,
+		&quoted,
+		&publishAt,
+		&createdAt,
+		&updatedAt,
+		&st.Error,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	st.ReplyTo = replyTo.Int64
+	st.QuoteTweetId = quoted.Int64
+	if publishAt.Valid {
+		st.PublishAt, _ = ptypes.TimestampProto(publishAt.Time)
+	}
+	st.CreatedAt, _ = ptypes.TimestampProto(createdAt)
+	st.UpdatedAt, _ = ptypes.TimestampProto(updatedAt)
+	return st, nil
+}
+
+// nullTimestamp return the publication date of a scheduled tweet, null
+// for drafts.
+func nullTimestamp(st *pb.ScheduledTweet) (sql.NullTime, error) {
+	if st.PublishAt == nil {
+		return sql.NullTime{}, nil
+	}
+
+	t, err := ptypes.Timestamp(st.PublishAt)
+	if err != nil {
+		return sql.NullTime{}, fmt.Errorf("Invalid publication date: %v", err)
+	}
+	return sql.NullTime{Time: t, Valid: true}, nil
+}
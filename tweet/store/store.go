@@ -3,21 +3,103 @@ package store
 import (
 	"context"
 
+	"github.com/idirall22/twee/common"
 	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/outbox"
 )
 
 // Store interface
 type Store interface {
-	// create tweet
-	Create(ctx context.Context, userID int64, content string) (int64, error)
-	// update tweet
-	Update(ctx context.Context, userID int64, id int64, content string) error
+	outbox.Store
+
+	// create tweet, its events are written to the outbox in the same transaction
+	Create(ctx context.Context, userID int64, tweet *pb.Tweet, events ...*pb.TweetEvent) (int64, error)
+	// retweet a tweet, its events are written to the outbox in the same transaction
+	Retweet(ctx context.Context, userID int64, originalID int64, events ...*pb.TweetEvent) (int64, error)
+	// undo a retweet, its events are written to the outbox in the same transaction
+	UndoRetweet(ctx context.Context, userID int64, originalID int64, events ...*pb.TweetEvent) error
+	// update tweet content keeping the previous one as a revision
+	Update(ctx context.Context, userID int64, id int64, content string, entities []*pb.TweetEntity, events ...*pb.TweetEvent) error
+	// list tweet revisions oldest first
+	ListRevisions(ctx context.Context, viewerID, tweetID int64) ([]*pb.TweetRevision, error)
 	// delete tweet
-	Delete(ctx context.Context, userID int64, id int64) error
+	Delete(ctx context.Context, userID int64, id int64, events ...*pb.TweetEvent) error
 	// get tweet
 	Get(ctx context.Context, userID int64, id int64) (*pb.Tweet, error)
-	// list tweets
-	List(ctx context.Context, userID int64, page int) ([]*pb.Tweet, error)
+	// get tweets by id keeping the ids order
+	GetMany(ctx context.Context, viewerID int64, ids []int64) ([]*pb.Tweet, error)
+	// list user tweets newest first
+	List(ctx context.Context, viewerID, userID int64, page *common.Page) ([]*pb.Tweet, error)
+	// list conversation tweets
+	Conversation(ctx context.Context, viewerID, conversationID int64) ([]*pb.Tweet, error)
+	// like a tweet
+	Like(ctx context.Context, userID int64, tweetID int64, events ...*pb.TweetEvent) error
+	// unlike a tweet
+	Unlike(ctx context.Context, userID int64, tweetID int64) error
+	// list users who liked a tweet
+	ListLikers(ctx context.Context, viewerID, tweetID int64, limit, offset int32, found func(user *pb.User) error) error
+	// bookmark a tweet in a folder, 0 for no folder
+	Bookmark(ctx context.Context, userID int64, tweetID int64, folderID int64) error
+	// remove a bookmark
+	Unbookmark(ctx context.Context, userID int64, tweetID int64) error
+	// list bookmarked tweets of a folder, all folders when 0
+	ListBookmarks(ctx context.Context, userID int64, folderID int64, limit, offset int32) ([]*pb.Tweet, error)
+	// create a bookmark folder
+	CreateBookmarkFolder(ctx context.Context, userID int64, name string) (*pb.BookmarkFolder, error)
+	// list bookmark folders
+	ListBookmarkFolders(ctx context.Context, userID int64) ([]*pb.BookmarkFolder, error)
+	// delete a bookmark folder and its bookmarks
+	DeleteBookmarkFolder(ctx context.Context, userID int64, id int64) error
+	// pin a user tweet on its profile
+	Pin(ctx context.Context, userID int64, tweetID int64, events ...*pb.TweetEvent) error
+	// unpin the user pinned tweet, events are about the unpinned tweet
+	Unpin(ctx context.Context, userID int64, events ...*pb.TweetEvent) error
+	// find users id by username, keyed by lower case username
+	FindUserIDs(ctx context.Context, usernames []string) (map[string]int64, error)
+	// list tweets with a hashtag
+	ListHashtag(ctx context.Context, viewerID int64, tag string, limit, offset int32) ([]*pb.Tweet, error)
+	// create a draft or a scheduled tweet
+	CreateScheduled(ctx context.Context, userID int64, st *pb.ScheduledTweet) (*pb.ScheduledTweet, error)
+	// list drafts or scheduled tweets
+	ListScheduled(ctx context.Context, userID int64, drafts bool) ([]*pb.ScheduledTweet, error)
+	// update the content or publication date of a scheduled tweet
+	UpdateScheduled(ctx context.Context, userID int64, st *pb.ScheduledTweet) (*pb.ScheduledTweet, error)
+	// delete a draft or cancel a scheduled tweet
+	DeleteScheduled(ctx context.Context, userID int64, id int64) error
+	// create the tweets of due scheduled tweets, each one exactly once
+	PublishDue(ctx context.Context, limit int, build ScheduledBuilder) ([]*PublishedTweet, error)
+	// save an uploaded media
+	CreateMedia(ctx context.Context, userID int64, m *pb.Media) (int64, error)
+	// find a user media keeping the ids order
+	FindMedia(ctx context.Context, userID int64, ids []int64) ([]*pb.Media, error)
+	// vote in a poll
+	Vote(ctx context.Context, userID int64, tweetID int64, option int32) error
+	// close polls past their closing time, each one exactly once
+	ClosePolls(ctx context.Context, limit int, events ClosedPollEvents) ([]*ClosedPoll, error)
 	// Close
 	Close() error
 }
+
+// ClosedPoll a poll that has just been closed.
+type ClosedPoll struct {
+	TweetID  int64
+	UserID   int64
+	Username string
+	VoterIDs []int64
+}
+
+// ClosedPollEvents build the events of a closed poll.
+type ClosedPollEvents func(c *ClosedPoll) []*pb.TweetEvent
+
+// PublishedTweet a tweet created from a scheduled tweet.
+type PublishedTweet struct {
+	Scheduled *pb.ScheduledTweet
+	Tweet     *pb.Tweet
+	// ParentUserID author of the replied tweet.
+	ParentUserID int64
+	// Events written to the outbox with the tweet.
+	Events []*pb.TweetEvent
+}
+
+// ScheduledBuilder build the tweet to create for a due scheduled tweet.
+type ScheduledBuilder func(st *pb.ScheduledTweet) (*PublishedTweet, error)
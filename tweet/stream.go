@@ -0,0 +1,87 @@
+package tweet
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/tweet/filter"
+)
+
+const (
+	// maxStreamRules maximum number of rules of a stream.
+	maxStreamRules = 50
+	// streamBuffer number of tweets buffered by stream, tweets are dropped
+	// for streams that do not keep up.
+	streamBuffer = 64
+)
+
+// StreamTweets stream new tweets matching the request rules.
+func (s *Server) StreamTweets(req *pb.StreamTweetsRequest, stream pb.TweetService_StreamTweetsServer) error {
+	// get user infos from context
+	_, err := auth.GetUserInfosFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	rules := filter.Rules{
+		Keywords:  req.GetKeywords(),
+		Hashtags:  req.GetHashtags(),
+		AuthorIDs: req.GetAuthorIds(),
+	}
+	if rules.Count() > maxStreamRules {
+		return status.Errorf(codes.InvalidArgument, "Too many rules, maximum is %d", maxStreamRules)
+	}
+
+	sub, err := s.hub.Subscribe(rules)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid rules: %v", err)
+	}
+	defer s.hub.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case m := <-sub.C:
+			err := stream.Send(&pb.StreamTweetsResponse{
+				Tweet:        m.Tweet,
+				MatchedRules: m.Rules,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not send tweet: %v", err)
+			}
+		}
+	}
+}
+
+// dispatch send created tweets to the streams.
+func (s *Server) dispatch(events <-chan *pb.TweetEvent) {
+	for e := range events {
+		if e.Action != pb.Action_CREATED {
+			continue
+		}
+		// likes and mentions refer to tweets already dispatched.
+		if e.Type != pb.Type_UNKNOWNE_TYPE && e.Type != pb.Type_TWEET {
+			continue
+		}
+		if s.hub.Len() == 0 {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		tweet, err := s.tweetStore.Get(ctx, 0, e.TweetId)
+		cancel()
+		if err != nil {
+			log.Printf("Could not get tweet %d to stream: %v", e.TweetId, err)
+			continue
+		}
+
+		s.hub.Publish(tweet)
+	}
+}
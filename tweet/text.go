@@ -0,0 +1,47 @@
+package tweet
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/idirall22/twee/tweettext"
+)
+
+// SetTextConfig set the weighted length limit of tweets contents.
+func (s *Server) SetTextConfig(c *tweettext.Config) {
+	s.textConfig = c
+}
+
+// validateContent return the normalized content, errors are field
+// violations of the content field.
+func (s *Server) validateContent(content string) (string, error) {
+	res, err := s.textConfig.Parse(content)
+	if err != nil {
+		return "", invalidContent(err.Error())
+	}
+	if !res.Valid {
+		return "", invalidContent(fmt.Sprintf(
+			"Content is %d characters long, the limit is %d",
+			res.WeightedLength, s.textConfig.MaxWeightedLength,
+		))
+	}
+	return res.Text, nil
+}
+
+// invalidContent return an InvalidArgument error with a content field
+// violation.
+func invalidContent(description string) error {
+	st := status.New(codes.InvalidArgument, description)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "content", Description: description},
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
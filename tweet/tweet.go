@@ -3,27 +3,52 @@ package tweet
 import (
 	"context"
 	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	// postgres driver
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/entity"
 	"github.com/idirall22/twee/pb"
 	eventstore "github.com/idirall22/twee/tweet/event_store"
+	"github.com/idirall22/twee/tweet/filter"
+	"github.com/idirall22/twee/tweet/media"
+	"github.com/idirall22/twee/tweet/outbox"
+	"github.com/idirall22/twee/tweet/search"
 	"github.com/idirall22/twee/tweet/store"
+	"github.com/idirall22/twee/tweettext"
+	"github.com/idirall22/twee/utils"
 )
 
+// maxListLimit maximum number of tweets listed at once.
+var maxListLimit int32 = 50
+
+// editWindow duration after the creation of a tweet during which it can
+// be edited.
+var editWindow = 30 * time.Minute
+
 // Server server
 type Server struct {
 	tweetStore         store.Store
 	notificationClient *pb.NotificationServiceClient
 	eventStore         eventstore.EventStore
+	searcher           search.Searcher
+	blobStore          media.BlobStore
+	hub                *filter.Hub
+	textConfig         *tweettext.Config
+	done               chan struct{}
 }
 
 // NewTweetServer create new tweet server
-func NewTweetServer(s store.Store, es eventstore.EventStore) (*Server, error) {
+func NewTweetServer(s store.Store, es eventstore.EventStore, se search.Searcher, bs media.BlobStore) (*Server, error) {
 	if s == nil {
 		return nil, fmt.Errorf("Store should not be NIL")
 	}
@@ -32,12 +57,35 @@ func NewTweetServer(s store.Store, es eventstore.EventStore) (*Server, error) {
 		return nil, fmt.Errorf("Event Store should not be NIL")
 	}
 
+	if se == nil {
+		return nil, fmt.Errorf("Searcher should not be NIL")
+	}
+
+	if bs == nil {
+		return nil, fmt.Errorf("Blob Store should not be NIL")
+	}
+
 	go es.Start()
 
-	return &Server{
+	events, err := es.Subscribe()
+	if err != nil {
+		return nil, fmt.Errorf("Could not subscribe to tweet events: %v", err)
+	}
+
+	server := &Server{
 		tweetStore: s,
 		eventStore: es,
-	}, nil
+		searcher:   se,
+		blobStore:  bs,
+		hub:        filter.NewHub(streamBuffer),
+		textConfig: tweettext.DefaultConfig,
+		done:       make(chan struct{}),
+	}
+	go server.dispatch(events)
+	go server.schedule()
+	go outbox.NewRelay(s, es).Run(server.done)
+
+	return server, nil
 }
 
 // Create a tweet.
@@ -48,35 +96,128 @@ func (s *Server) Create(ctx context.Context, req *pb.CreateTweetRequest) (*pb.Cr
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	content := req.Content
-	if len(content) == 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "tweet Content is empty")
+	tweet, parentUserID, err := s.prepareTweet(ctx, userInfos.ID, req)
+	if err != nil {
+		return nil, err
 	}
 
-	userID := userInfos.ID
-	username := userInfos.Username
-
-	id, err := s.tweetStore.Create(ctx, userID, content)
+	events := createdEvents(userInfos.ID, userInfos.Username, tweet, parentUserID)
+	id, err := s.tweetStore.Create(ctx, userInfos.ID, tweet, events...)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Error to create a tweet: %v", err)
 	}
 
-	res := &pb.CreateTweetResponse{
-		Id: id,
+	tweet.Id = id
+	s.published(ctx, userInfos.ID, tweet)
+
+	return &pb.CreateTweetResponse{Id: id}, nil
+}
+
+// prepareTweet build a tweet from a create request, it return the replied
+// tweet author, errors are grpc status.
+func (s *Server) prepareTweet(ctx context.Context, userID int64, req *pb.CreateTweetRequest) (*pb.Tweet, int64, error) {
+	if len(req.GetContent()) == 0 && len(req.GetMediaIds()) == 0 {
+		return nil, 0, invalidContent("Content is empty")
+	}
+
+	content, err := s.validateContent(req.GetContent())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entities, err := s.parseEntities(ctx, content)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "Could not parse tweet entities: %v", err)
+	}
+
+	if _, ok := pb.Visibility_name[int32(req.GetVisibility())]; !ok {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "Invalid visibility")
+	}
+	if _, ok := pb.ReplyPolicy_name[int32(req.GetReplyPolicy())]; !ok {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "Invalid reply policy")
+	}
+
+	tweet := &pb.Tweet{
+		Content:     content,
+		Entities:    entities,
+		Visibility:  req.GetVisibility(),
+		ReplyPolicy: req.GetReplyPolicy(),
+	}
+
+	var parentUserID int64
+	if req.GetReplyTo() != 0 {
+		parent, err := s.getOriginal(ctx, userID, req.GetReplyTo())
+		if err == utils.ErrNotExists {
+			return nil, 0, status.Errorf(codes.NotFound, "Replied tweet not exists")
+		}
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "Could not get replied tweet: %v", err)
+		}
+		if !parent.CanReply {
+			return nil, 0, status.Errorf(codes.PermissionDenied, "Not allowed to reply to this tweet")
+		}
+
+		parentUserID, err = strconv.ParseInt(parent.UserId, 10, 64)
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "Invalid replied tweet user id: %v", err)
+		}
+
+		tweet.InReplyToTweetId = parent.Id
+		tweet.ConversationId = parent.ConversationId
+		// the parent was created before conversations were tracked.
+		if tweet.ConversationId == 0 {
+			tweet.ConversationId = parent.Id
+		}
 	}
 
+	if req.GetQuoteTweetId() != 0 {
+		quoted, err := s.getOriginal(ctx, userID, req.GetQuoteTweetId())
+		if err == utils.ErrNotExists {
+			return nil, 0, status.Errorf(codes.NotFound, "Quoted tweet not exists")
+		}
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "Could not get quoted tweet: %v", err)
+		}
+		tweet.QuotedTweetId = quoted.Id
+	}
+
+	tweet.Media, err = s.attachedMedia(ctx, userID, req.GetMediaIds())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tweet.Poll, err = newPoll(req.GetPoll())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tweet, parentUserID, nil
+}
+
+// published index a created tweet.
+func (s *Server) published(ctx context.Context, userID int64, tweet *pb.Tweet) {
+	tweet.UserId = strconv.FormatInt(userID, 10)
+	tweet.CreatedAt = ptypes.TimestampNow()
+	s.index(ctx, tweet)
+}
+
+// createdEvents build the events of a tweet about to be created, the
+// tweet id is set by the store.
+func createdEvents(userID int64, username string, tweet *pb.Tweet, parentUserID int64) []*pb.TweetEvent {
 	e := &pb.TweetEvent{
-		Action:  pb.Action_CREATED,
-		Title:   fmt.Sprintf("%s has just tweeted", username),
-		TweetId: id,
-		UserId:  userID,
+		Action:     pb.Action_CREATED,
+		Title:      fmt.Sprintf("%s has just tweeted", username),
+		UserId:     userID,
+		Visibility: tweet.Visibility,
 	}
 
-	go func() {
-		s.eventStore.Publish(ctx, e)
-	}()
+	if tweet.InReplyToTweetId != 0 {
+		e.Title = fmt.Sprintf("%s replied to your tweet", username)
+		e.InReplyToTweetId = tweet.InReplyToTweetId
+		e.InReplyToUserId = parentUserID
+	}
 
-	return res, nil
+	return append([]*pb.TweetEvent{e}, mentionEvents(userID, username, 0, entity.MentionedUserIDs(tweet.Entities))...)
 }
 
 // Update a tweet.
@@ -95,13 +236,75 @@ func (s *Server) Update(ctx context.Context, req *pb.UpdateTweetRequest) (*pb.Up
 	}
 
 	if len(content) == 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "empty content")
+		return nil, invalidContent("Content is empty")
+	}
+
+	content, err = s.validateContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	old, err := s.tweetStore.Get(ctx, userInfos.ID, id)
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get tweet: %v", err)
+	}
+
+	if old.UserId != strconv.FormatInt(userInfos.ID, 10) {
+		return nil, status.Errorf(codes.PermissionDenied, "Only the author can edit a tweet")
+	}
+
+	if old.RetweetOfId != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "A retweet can not be edited")
+	}
+
+	createdAt, err := ptypes.Timestamp(old.CreatedAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Invalid tweet creation date: %v", err)
+	}
+	if time.Since(createdAt) > editWindow {
+		return nil, status.Errorf(codes.FailedPrecondition, "Tweets can only be edited %v after their creation", editWindow)
+	}
+
+	entities, err := s.parseEntities(ctx, content)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not parse tweet entities: %v", err)
+	}
+
+	// only notify users that were not already mentioned.
+	mentioned := map[int64]bool{}
+	for _, userID := range entity.MentionedUserIDs(old.Entities) {
+		mentioned[userID] = true
+	}
+	newMentions := []int64{}
+	for _, userID := range entity.MentionedUserIDs(entities) {
+		if !mentioned[userID] {
+			newMentions = append(newMentions, userID)
+		}
+	}
+
+	e := &pb.TweetEvent{
+		Action:  pb.Action_UPDATED,
+		Title:   fmt.Sprintf("%s edited a tweet", userInfos.Username),
+		TweetId: id,
+		UserId:  userInfos.ID,
 	}
+	events := append([]*pb.TweetEvent{e}, mentionEvents(userInfos.ID, userInfos.Username, id, newMentions)...)
 
-	err = s.tweetStore.Update(ctx, userInfos.ID, id, content)
+	err = s.tweetStore.Update(ctx, userInfos.ID, id, content, entities, events...)
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Error to update the tweet: %v", err)
 	}
+
+	old.Content = content
+	old.Entities = entities
+	s.index(ctx, old)
+
 	return &pb.UpdateTweetResponse{}, nil
 }
 
@@ -115,11 +318,26 @@ func (s *Server) Delete(ctx context.Context, req *pb.DeleteTweetRequest) (*pb.De
 
 	id := req.GetId()
 
-	err = s.tweetStore.Delete(ctx, userInfos.ID, id)
+	e := &pb.TweetEvent{
+		Action:  pb.Action_DELETED,
+		Title:   fmt.Sprintf("%s deleted a tweet", userInfos.Username),
+		TweetId: id,
+		UserId:  userInfos.ID,
+	}
+
+	err = s.tweetStore.Delete(ctx, userInfos.ID, id, e)
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not delete tweet: %v", err)
 	}
 
+	err = s.searcher.Remove(ctx, id)
+	if err != nil {
+		log.Printf("Could not remove tweet %d from search index: %v", id, err)
+	}
+
 	return &pb.DeleteTweetResponse{}, nil
 }
 
@@ -133,6 +351,9 @@ func (s *Server) Get(ctx context.Context, req *pb.GetTweetRequest) (*pb.GetTweet
 
 	id := req.GetId()
 	tweet, err := s.tweetStore.Get(ctx, userInfos.ID, id)
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "Tweet not exists")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get tweet: %v", err)
 	}
@@ -144,15 +365,40 @@ func (s *Server) Get(ctx context.Context, req *pb.GetTweetRequest) (*pb.GetTweet
 
 // List a user tweets using user id.
 func (s *Server) List(req *pb.ListTweetRequest, stream pb.TweetService_ListServer) error {
-	tweets, err := s.tweetStore.List(stream.Context(), req.UserId, int(req.Limit))
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	after, err := common.DecodePageCursor(req.GetPageToken())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	limit := req.GetLimit()
+	if limit <= 0 || limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	tweets, err := s.tweetStore.List(stream.Context(), userInfos.ID, req.GetUserId(), &common.Page{
+		After:   after,
+		MaxID:   req.GetMaxId(),
+		SinceID: req.GetSinceId(),
+		Limit:   limit,
+	})
 	if err != nil {
 		return status.Errorf(codes.Internal, "Could not list tweets: %v", err)
 	}
-	res := &pb.ListTweetResponse{}
 
 	for _, tweet := range tweets {
-		res.Tweet = tweet
-		err := stream.Send(res)
+		createdAt, err := ptypes.Timestamp(tweet.CreatedAt)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Invalid tweet created at: %v", err)
+		}
+
+		cursor := &common.PageCursor{CreatedAt: createdAt, ID: tweet.Id}
+		err = stream.Send(&pb.ListTweetResponse{Tweet: tweet, NextPageToken: cursor.Encode()})
 		if err != nil {
 			return status.Errorf(codes.Internal, "Could not send tweet: %v", err)
 		}
@@ -161,7 +407,100 @@ func (s *Server) List(req *pb.ListTweetRequest, stream pb.TweetService_ListServe
 	return nil
 }
 
-// Close store connection
+// ListRevisions list the edit history of a tweet, oldest first.
+func (s *Server) ListRevisions(req *pb.ListRevisionsRequest, stream pb.TweetService_ListRevisionsServer) error {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	revisions, err := s.tweetStore.ListRevisions(stream.Context(), userInfos.ID, req.GetTweetId())
+	if err == utils.ErrNotExists {
+		return status.Errorf(codes.NotFound, "Tweet not exists")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not list revisions: %v", err)
+	}
+
+	for _, revision := range revisions {
+		err := stream.Send(&pb.ListRevisionsResponse{Revision: revision})
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not send revision: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// ListHashtagTweets list tweets with a hashtag, newest first.
+func (s *Server) ListHashtagTweets(req *pb.ListHashtagTweetsRequest, stream pb.TweetService_ListHashtagTweetsServer) error {
+	// get user infos from context
+	userInfos, err := auth.GetUserInfosFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	hashtag := strings.TrimPrefix(req.GetHashtag(), "#")
+	if len(hashtag) == 0 {
+		return status.Errorf(codes.InvalidArgument, "Empty hashtag")
+	}
+
+	limit := req.GetLimit()
+	if limit <= 0 || limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	tweets, err := s.tweetStore.ListHashtag(stream.Context(), userInfos.ID, hashtag, limit, req.GetOffset())
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not list tweets: %v", err)
+	}
+
+	for _, tweet := range tweets {
+		err := stream.Send(&pb.ListTweetResponse{Tweet: tweet})
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not send tweet: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// parseEntities extract content entities and resolve mentions.
+func (s *Server) parseEntities(ctx context.Context, content string) ([]*pb.TweetEntity, error) {
+	entities := entity.Parse(content)
+
+	ids, err := s.tweetStore.FindUserIDs(ctx, entity.Mentions(entities))
+	if err != nil {
+		return nil, err
+	}
+	return entity.ResolveMentions(entities, ids), nil
+}
+
+// mentionEvents build the MENTION event of mentioned users, if any.
+func mentionEvents(userID int64, username string, tweetID int64, mentioned []int64) []*pb.TweetEvent {
+	if len(mentioned) == 0 {
+		return nil
+	}
+
+	e := &pb.TweetEvent{
+		Action:           pb.Action_CREATED,
+		Type:             pb.Type_MENTION,
+		Title:            fmt.Sprintf("%s mentioned you", username),
+		TweetId:          tweetID,
+		UserId:           userID,
+		MentionedUserIds: mentioned,
+	}
+	return []*pb.TweetEvent{e}
+}
+
+// Close store and searcher connections
 func (s *Server) Close() error {
+	close(s.done)
+
+	err := s.searcher.Close()
+	if err != nil {
+		return err
+	}
 	return s.tweetStore.Close()
 }
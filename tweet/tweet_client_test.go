@@ -1,26 +1,41 @@
 package tweet_test
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"image"
+	"image/png"
 	"io"
 	"log"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+
 	"github.com/idirall22/twee/follow"
 	fpostgresstore "github.com/idirall22/twee/follow/store/postgres"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/idirall22/twee/auth"
 	"github.com/idirall22/twee/common"
 	sample "github.com/idirall22/twee/generator"
+	"github.com/idirall22/twee/idempotency"
+	memoryidempotency "github.com/idirall22/twee/idempotency/memory"
 	"github.com/idirall22/twee/pb"
 	"github.com/idirall22/twee/tweet"
 	teventstore "github.com/idirall22/twee/tweet/event_store/stan"
+	memoryblobstore "github.com/idirall22/twee/tweet/media/memory"
+	postgressearch "github.com/idirall22/twee/tweet/search/postgres"
 	postgresstore "github.com/idirall22/twee/tweet/store/postgres"
 )
 
@@ -94,22 +109,454 @@ func TestCreateTweets(t *testing.T) {
 		createdIds = append(createdIds, resCreate.Id)
 	}
 
-	// List tweets
-	reqList := sample.NewRequestListTweet(1)
-	stream, err := tweetClient.List(ctx1, reqList)
+	// List tweets newest first one page at a time
+	listTweets := func(req *pb.ListTweetRequest) ([]int64, string) {
+		stream, err := tweetClient.List(ctx1, req)
+		require.NoError(t, err)
+		require.NotNil(t, stream)
+
+		ids, token := []int64{}, ""
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				log.Fatalf("Error to receive stream of tweets: %v", err)
+			}
+			ids = append(ids, res.Tweet.Id)
+			token = res.NextPageToken
+		}
+		return ids, token
+	}
+
+	reqList := sample.NewRequestListTweet(userClaims1.ID)
+	reqList.Limit = 1
+	ids, token := listTweets(reqList)
+	require.Equal(t, []int64{createdIds[1]}, ids)
+
+	reqList.PageToken = token
+	ids, token = listTweets(reqList)
+	require.Equal(t, []int64{createdIds[0]}, ids)
+
+	reqList.PageToken = token
+	ids, _ = listTweets(reqList)
+	require.Empty(t, ids)
+
+	ids, _ = listTweets(&pb.ListTweetRequest{UserId: userClaims1.ID, SinceId: createdIds[0]})
+	require.Equal(t, []int64{createdIds[1]}, ids)
+
+	ids, _ = listTweets(&pb.ListTweetRequest{UserId: userClaims1.ID, MaxId: createdIds[0]})
+	require.Equal(t, []int64{createdIds[0]}, ids)
+
+	// retrying a create with the same idempotency key does not duplicate it
+	ctxKey := metadata.AppendToOutgoingContext(ctx1, idempotency.KeyHeader, fmt.Sprintf("create-%d", time.Now().UnixNano()))
+	reqIdempotent := sample.NewRequestCreateTweet()
+	resIdempotent, err := tweetClient.Create(ctxKey, reqIdempotent)
+	require.NoError(t, err)
+
+	resRetry, err := tweetClient.Create(ctxKey, reqIdempotent)
+	require.NoError(t, err)
+	require.Equal(t, resIdempotent.Id, resRetry.Id)
+
+	// user 2 reply to user 1 tweet and user 1 reply back
+	reqReply := sample.NewRequestReplyTweet(createdIds[0])
+	resReply, err := tweetClient.Create(ctx2, reqReply)
+	require.NoError(t, err)
+	require.NotNil(t, resReply)
+
+	resReply2, err := tweetClient.Create(ctx1, sample.NewRequestReplyTweet(resReply.Id))
+	require.NoError(t, err)
+	require.NotNil(t, resReply2)
+
+	// get conversation from the last reply
+	convStream, err := tweetClient.GetConversation(ctx1, &pb.GetConversationRequest{TweetId: resReply2.Id})
+	require.NoError(t, err)
+	require.NotNil(t, convStream)
+
+	depths := map[int64]int32{}
+	for {
+		res, err := convStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		depths[res.Tweet.Id] = res.Depth
+	}
+	require.Equal(t, int32(0), depths[createdIds[0]])
+	require.Equal(t, int32(1), depths[resReply.Id])
+	require.Equal(t, int32(2), depths[resReply2.Id])
+
+	// contents over the length limit are field violations
+	_, err = tweetClient.Create(ctx1, &pb.CreateTweetRequest{Content: strings.Repeat("日", 141)})
+	require.Error(t, err)
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	require.Equal(t, "content", st.Details()[0].(*errdetails.BadRequest).FieldViolations[0].Field)
+
+	// user 1 mention user 2 with a hashtag and an url
+	hashtag := fmt.Sprintf("twee%d", time.Now().UnixNano())
+
+	// user 2 stream tweets with the hashtag
+	liveStream, err := tweetClient.StreamTweets(ctx2, &pb.StreamTweetsRequest{Hashtags: []string{hashtag}})
+	require.NoError(t, err)
+	// wait for the stream rules to be registered.
+	time.Sleep(time.Millisecond * 200)
+
+	resEntities, err := tweetClient.Create(ctx1, &pb.CreateTweetRequest{
+		Content: fmt.Sprintf("hello @%s #%s https://twee.io", reqReg2.Username, hashtag),
+	})
+	require.NoError(t, err)
+
+	resGetEntities, err := tweetClient.Get(ctx1, sample.NewRequestGetTweet(resEntities.Id))
+	require.NoError(t, err)
+	require.Len(t, resGetEntities.Tweet.Entities, 3)
+	require.NotZero(t, resGetEntities.Tweet.Entities[0].UserId)
+
+	resLive, err := liveStream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, resEntities.Id, resLive.Tweet.Id)
+	require.Equal(t, []string{"hashtag:" + hashtag}, resLive.MatchedRules)
+
+	hashtagStream, err := tweetClient.ListHashtagTweets(ctx1, &pb.ListHashtagTweetsRequest{Hashtag: hashtag})
+	require.NoError(t, err)
+
+	resHashtag, err := hashtagStream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, resEntities.Id, resHashtag.Tweet.Id)
+
+	// search the tweet by words, author and hashtag
+	searchStream, err := tweetClient.SearchTweets(ctx2, &pb.SearchTweetsRequest{
+		Query: fmt.Sprintf(`"hello" from:%s #%s -goodbye`, reqReg1.Username, hashtag),
+	})
+	require.NoError(t, err)
+
+	resSearch, err := searchStream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, resEntities.Id, resSearch.Tweet.Id)
+	require.NotEmpty(t, resSearch.NextPageToken)
+
+	searchStream, err = tweetClient.SearchTweets(ctx2, &pb.SearchTweetsRequest{
+		Query:     fmt.Sprintf("#%s", hashtag),
+		PageToken: resSearch.NextPageToken,
+	})
+	require.NoError(t, err)
+
+	_, err = searchStream.Recv()
+	require.Equal(t, io.EOF, err)
+
+	// user 1 edit a tweet, user 2 can not
+	_, err = tweetClient.Update(ctx2, sample.NewRequestUpdateTweet(createdIds[0]))
+	require.Error(t, err)
+
+	reqUpdate := sample.NewRequestUpdateTweet(createdIds[0])
+	_, err = tweetClient.Update(ctx1, reqUpdate)
+	require.NoError(t, err)
+
+	resGetEdited, err := tweetClient.Get(ctx1, sample.NewRequestGetTweet(createdIds[0]))
+	require.NoError(t, err)
+	require.Equal(t, reqUpdate.Content, resGetEdited.Tweet.Content)
+	require.Equal(t, uint32(1), resGetEdited.Tweet.RevisionCount)
+	require.NotNil(t, resGetEdited.Tweet.EditedAt)
+
+	revisionsStream, err := tweetClient.ListRevisions(ctx2, &pb.ListRevisionsRequest{TweetId: createdIds[0]})
+	require.NoError(t, err)
+
+	revisions := []*pb.TweetRevision{}
+	for {
+		res, err := revisionsStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		revisions = append(revisions, res.Revision)
+	}
+	require.Len(t, revisions, 2)
+	require.Equal(t, reqUpdate.Content, revisions[1].Content)
+
+	// user 1 save a draft then schedule it
+	resDraft, err := tweetClient.CreateScheduledTweet(ctx1, &pb.CreateScheduledTweetRequest{
+		Content: sample.NewRequestCreateTweet().Content,
+	})
+	require.NoError(t, err)
+	require.Nil(t, resDraft.ScheduledTweet.PublishAt)
+
+	listScheduled := func(drafts bool) []int64 {
+		stream, err := tweetClient.ListScheduledTweets(ctx1, &pb.ListScheduledTweetsRequest{Drafts: drafts})
+		require.NoError(t, err)
+
+		ids := []int64{}
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			ids = append(ids, res.ScheduledTweet.Id)
+		}
+		return ids
+	}
+	require.Contains(t, listScheduled(true), resDraft.ScheduledTweet.Id)
+
+	publishAt, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	resSchedule, err := tweetClient.UpdateScheduledTweet(ctx1, &pb.UpdateScheduledTweetRequest{
+		Id:        resDraft.ScheduledTweet.Id,
+		PublishAt: publishAt,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resSchedule.ScheduledTweet.PublishAt)
+	require.Contains(t, listScheduled(false), resDraft.ScheduledTweet.Id)
+
+	// publication dates in the past are rejected
+	_, err = tweetClient.UpdateScheduledTweet(ctx1, &pb.UpdateScheduledTweetRequest{
+		Id:        resDraft.ScheduledTweet.Id,
+		PublishAt: resSchedule.ScheduledTweet.CreatedAt,
+	})
+	require.Error(t, err)
+
+	// user 2 can not cancel user 1 scheduled tweet
+	_, err = tweetClient.CancelScheduledTweet(ctx2, &pb.CancelScheduledTweetRequest{Id: resDraft.ScheduledTweet.Id})
+	require.Error(t, err)
+
+	_, err = tweetClient.CancelScheduledTweet(ctx1, &pb.CancelScheduledTweetRequest{Id: resDraft.ScheduledTweet.Id})
+	require.NoError(t, err)
+	require.NotContains(t, listScheduled(false), resDraft.ScheduledTweet.Id)
+
+	// user 1 upload an image in two chunks and attach it to a tweet
+	img := &bytes.Buffer{}
+	require.NoError(t, png.Encode(img, image.NewRGBA(image.Rect(0, 0, 3, 2))))
+
+	uploadStream, err := tweetClient.UploadMedia(ctx1)
+	require.NoError(t, err)
+	require.NoError(t, uploadStream.Send(&pb.UploadMediaRequest{Chunk: img.Bytes()[:10]}))
+	require.NoError(t, uploadStream.Send(&pb.UploadMediaRequest{Chunk: img.Bytes()[10:]}))
+
+	resUpload, err := uploadStream.CloseAndRecv()
+	require.NoError(t, err)
+	require.Equal(t, "image/png", resUpload.Media.ContentType)
+	require.Equal(t, int32(3), resUpload.Media.Width)
+	require.Equal(t, int32(2), resUpload.Media.Height)
+
+	resMediaTweet, err := tweetClient.Create(ctx1, &pb.CreateTweetRequest{MediaIds: []int64{resUpload.Media.Id}})
+	require.NoError(t, err)
+
+	resGetMedia, err := tweetClient.Get(ctx2, sample.NewRequestGetTweet(resMediaTweet.Id))
+	require.NoError(t, err)
+	require.Len(t, resGetMedia.Tweet.Media, 1)
+	require.Equal(t, resUpload.Media.Sha256, resGetMedia.Tweet.Media[0].Sha256)
+
+	// user 2 can not attach user 1 media
+	_, err = tweetClient.Create(ctx2, &pb.CreateTweetRequest{MediaIds: []int64{resUpload.Media.Id}})
+	require.Error(t, err)
+
+	// user 1 create a poll, user 2 see results only after voting
+	closesAt, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	resPoll, err := tweetClient.Create(ctx1, &pb.CreateTweetRequest{
+		Content: "tabs or spaces?",
+		Poll:    &pb.NewPoll{Options: []string{"tabs", "spaces"}, ClosesAt: closesAt},
+	})
+	require.NoError(t, err)
+
+	resGetPoll, err := tweetClient.Get(ctx2, sample.NewRequestGetTweet(resPoll.Id))
+	require.NoError(t, err)
+	require.Len(t, resGetPoll.Tweet.Poll.Options, 2)
+	require.False(t, resGetPoll.Tweet.Poll.ResultsVisible)
+
+	resVote, err := tweetClient.Vote(ctx2, &pb.VoteRequest{TweetId: resPoll.Id, Option: 1})
+	require.NoError(t, err)
+	require.True(t, resVote.Poll.ResultsVisible)
+	require.True(t, resVote.Poll.Voted)
+	require.Equal(t, uint32(1), resVote.Poll.Options[1].Votes)
+
+	_, err = tweetClient.Vote(ctx2, &pb.VoteRequest{TweetId: resPoll.Id, Option: 0})
+	require.Error(t, err)
+
+	_, err = tweetClient.Create(ctx1, &pb.CreateTweetRequest{
+		Content: "one option poll",
+		Poll:    &pb.NewPoll{Options: []string{"yes"}, ClosesAt: closesAt},
+	})
+	require.Error(t, err)
+
+	// user 2 like user 1 tweet
+	_, err = tweetClient.Like(ctx2, &pb.LikeRequest{TweetId: createdIds[0]})
 	require.NoError(t, err)
-	require.NotNil(t, stream)
 
+	_, err = tweetClient.Like(ctx2, &pb.LikeRequest{TweetId: createdIds[0]})
+	require.Error(t, err)
+
+	resGetLiked, err := tweetClient.Get(ctx2, sample.NewRequestGetTweet(createdIds[0]))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resGetLiked.Tweet.LikeCount)
+	require.True(t, resGetLiked.Tweet.Liked)
+
+	likersStream, err := tweetClient.ListLikers(ctx1, &pb.ListLikersRequest{TweetId: createdIds[0]})
+	require.NoError(t, err)
+
+	likers := 0
 	for {
-		res, err := stream.Recv()
+		res, err := likersStream.Recv()
 		if err == io.EOF {
 			break
 		}
-		if err != nil {
-			log.Fatalf("Error to receive stream of tweets: %v", err)
+		require.NoError(t, err)
+		require.Equal(t, reqReg2.Username, res.User.Username)
+		likers++
+	}
+	require.Equal(t, 1, likers)
+
+	_, err = tweetClient.Unlike(ctx2, &pb.UnlikeRequest{TweetId: createdIds[0]})
+	require.NoError(t, err)
+
+	// user 2 retweet and quote user 1 tweet
+	resRetweet, err := tweetClient.Retweet(ctx2, &pb.RetweetRequest{TweetId: createdIds[1]})
+	require.NoError(t, err)
+	require.NotNil(t, resRetweet)
+
+	_, err = tweetClient.Retweet(ctx2, &pb.RetweetRequest{TweetId: resRetweet.Id})
+	require.Error(t, err)
+
+	resQuote, err := tweetClient.Create(ctx2, sample.NewRequestQuoteTweet(createdIds[1]))
+	require.NoError(t, err)
+	require.NotNil(t, resQuote)
+
+	resGet, err := tweetClient.Get(ctx2, sample.NewRequestGetTweet(createdIds[1]))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resGet.Tweet.RetweetCount)
+
+	// deleting the original keep a tombstone
+	_, err = tweetClient.Delete(ctx1, sample.NewRequestDeleteTweet(createdIds[1]))
+	require.NoError(t, err)
+
+	resGet, err = tweetClient.Get(ctx2, sample.NewRequestGetTweet(resQuote.Id))
+	require.NoError(t, err)
+	require.NotNil(t, resGet.Tweet.Original)
+	require.True(t, resGet.Tweet.Original.Deleted)
+
+	_, err = tweetClient.UndoRetweet(ctx2, &pb.UndoRetweetRequest{TweetId: createdIds[1]})
+	require.NoError(t, err)
+
+	// user 3 does not follow user 1
+	reqReg3 := sample.RandomRegisterRequest()
+	_, err = authClient.Register(ctx, reqReg3)
+	require.NoError(t, err)
+
+	resLogin3, err := authClient.Login(ctx, sample.LoginRequestFromRegisterRequest(reqReg3))
+	require.NoError(t, err)
+	ctx3 := metadata.AppendToOutgoingContext(context.Background(), auth.AuthKey, resLogin3.AccessToken)
+
+	// followers only tweet, replies restricted to mentioned users
+	resPrivate, err := tweetClient.Create(ctx1, &pb.CreateTweetRequest{
+		Content:     "for my followers",
+		Visibility:  pb.Visibility_FOLLOWERS_ONLY,
+		ReplyPolicy: pb.ReplyPolicy_REPLY_MENTIONED,
+	})
+	require.NoError(t, err)
+
+	resGetPrivate, err := tweetClient.Get(ctx2, sample.NewRequestGetTweet(resPrivate.Id))
+	require.NoError(t, err)
+	require.Equal(t, pb.Visibility_FOLLOWERS_ONLY, resGetPrivate.Tweet.Visibility)
+	require.False(t, resGetPrivate.Tweet.CanReply)
+
+	_, err = tweetClient.Get(ctx3, sample.NewRequestGetTweet(resPrivate.Id))
+	require.Error(t, err)
+
+	_, err = tweetClient.Create(ctx2, &pb.CreateTweetRequest{Content: "reply", ReplyTo: resPrivate.Id})
+	require.Error(t, err)
+
+	_, err = tweetClient.Retweet(ctx2, &pb.RetweetRequest{TweetId: resPrivate.Id})
+	require.Error(t, err)
+
+	// mentioned only tweet
+	resMentioned, err := tweetClient.Create(ctx1, &pb.CreateTweetRequest{
+		Content:    "hello @" + reqReg3.Username,
+		Visibility: pb.Visibility_MENTIONED_ONLY,
+	})
+	require.NoError(t, err)
+
+	_, err = tweetClient.Get(ctx2, sample.NewRequestGetTweet(resMentioned.Id))
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	resGetMentioned, err := tweetClient.Get(ctx3, sample.NewRequestGetTweet(resMentioned.Id))
+	require.NoError(t, err)
+	require.True(t, resGetMentioned.Tweet.CanReply)
+
+	// user 2 bookmarks in a folder
+	resFolder, err := tweetClient.CreateBookmarkFolder(ctx2, &pb.CreateBookmarkFolderRequest{Name: "Read later"})
+	require.NoError(t, err)
+
+	_, err = tweetClient.CreateBookmarkFolder(ctx2, &pb.CreateBookmarkFolderRequest{Name: "read LATER"})
+	require.Error(t, err)
+
+	_, err = tweetClient.Bookmark(ctx3, &pb.BookmarkRequest{TweetId: createdIds[0], FolderId: resFolder.Folder.Id})
+	require.Error(t, err)
+
+	resToDelete, err := tweetClient.Create(ctx1, sample.NewRequestCreateTweet())
+	require.NoError(t, err)
+
+	_, err = tweetClient.Bookmark(ctx2, &pb.BookmarkRequest{TweetId: createdIds[0], FolderId: resFolder.Folder.Id})
+	require.NoError(t, err)
+	_, err = tweetClient.Bookmark(ctx2, &pb.BookmarkRequest{TweetId: resToDelete.Id})
+	require.NoError(t, err)
+
+	listBookmarks := func(folderID int64) []int64 {
+		stream, err := tweetClient.ListBookmarks(ctx2, &pb.ListBookmarksRequest{FolderId: folderID})
+		require.NoError(t, err)
+
+		ids := []int64{}
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			require.True(t, res.Tweet.Bookmarked)
+			ids = append(ids, res.Tweet.Id)
 		}
-		require.NotNil(t, res)
+		return ids
 	}
+	require.Equal(t, []int64{resToDelete.Id, createdIds[0]}, listBookmarks(0))
+	require.Equal(t, []int64{createdIds[0]}, listBookmarks(resFolder.Folder.Id))
+
+	// deleted tweets disappear from bookmarks
+	_, err = tweetClient.Delete(ctx1, sample.NewRequestDeleteTweet(resToDelete.Id))
+	require.NoError(t, err)
+	require.Equal(t, []int64{createdIds[0]}, listBookmarks(0))
+
+	_, err = tweetClient.Unbookmark(ctx2, &pb.UnbookmarkRequest{TweetId: createdIds[0]})
+	require.NoError(t, err)
+	require.Empty(t, listBookmarks(0))
+
+	_, err = tweetClient.DeleteBookmarkFolder(ctx2, &pb.DeleteBookmarkFolderRequest{Id: resFolder.Folder.Id})
+	require.NoError(t, err)
+
+	// pinned tweets
+	_, err = tweetClient.PinTweet(ctx2, &pb.PinTweetRequest{TweetId: createdIds[0]})
+	require.Error(t, err)
+
+	_, err = tweetClient.PinTweet(ctx1, &pb.PinTweetRequest{TweetId: createdIds[1]})
+	require.Error(t, err)
+
+	resToPin, err := tweetClient.Create(ctx1, sample.NewRequestCreateTweet())
+	require.NoError(t, err)
+
+	_, err = tweetClient.PinTweet(ctx1, &pb.PinTweetRequest{TweetId: resToPin.Id})
+	require.NoError(t, err)
+
+	resGetPinned, err := tweetClient.Get(ctx2, sample.NewRequestGetTweet(resToPin.Id))
+	require.NoError(t, err)
+	require.True(t, resGetPinned.Tweet.Pinned)
+
+	// deleting the pinned tweet unpins it
+	_, err = tweetClient.Delete(ctx1, sample.NewRequestDeleteTweet(resToPin.Id))
+	require.NoError(t, err)
+
+	_, err = tweetClient.UnpinTweet(ctx1, &pb.UnpinTweetRequest{})
+	require.Error(t, err)
 
 	// // Delete tweets
 	// for _, tweetId := range createdIds {
@@ -134,12 +581,28 @@ func startTweetTestServer(t *testing.T, jwtManager *auth.JwtManager) string {
 	require.NoError(t, err)
 	require.NotNil(t, pStore)
 
-	server, err := tweet.NewTweetServer(pStore, es)
+	searcher, err := postgressearch.NewPostgresSearcher(common.PostgresTestOptions)
+	require.NoError(t, err)
+	require.NotNil(t, searcher)
+
+	server, err := tweet.NewTweetServer(pStore, es, searcher, memoryblobstore.NewMemoryBlobStore())
 	require.NoError(t, err)
 	require.NotNil(t, server)
 
 	jwtInterceptor := auth.NewJwtInterceptor(jwtManager)
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(jwtInterceptor.Unary()))
+	idempotencyInterceptor := idempotency.NewInterceptor(
+		memoryidempotency.NewMemoryStore(),
+		time.Minute,
+		time.Second*30,
+		idempotency.DefaultMethods,
+	)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			jwtInterceptor.Unary(),
+			idempotencyInterceptor.Unary(),
+		)),
+		grpc.StreamInterceptor(jwtInterceptor.Stream()),
+	)
 	pb.RegisterTweetServiceServer(grpcServer, server)
 
 	listner, err := net.Listen("tcp", ":0")
@@ -0,0 +1,92 @@
+package tweettext
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// zwj zero width joiner.
+const zwj = '\u200d'
+
+// Graphemes split a text in user perceived characters following a subset
+// of the Unicode extended grapheme cluster rules: CR LF, combining marks,
+// variation selectors, emoji modifiers and tags extend the previous
+// character, a zero width joiner joins two characters and regional
+// indicators are paired into flags.
+func Graphemes(text string) []string {
+	clusters := []string{}
+	start := 0
+	var prev rune
+	// regional indicators in a row ending with prev.
+	indicators := 0
+
+	for i, r := range text {
+		if i > start && isBoundary(prev, r, indicators) {
+			clusters = append(clusters, text[start:i])
+			start = i
+			indicators = 0
+		}
+
+		if isRegionalIndicator(r) {
+			indicators++
+		} else {
+			indicators = 0
+		}
+		prev = r
+	}
+
+	if start < len(text) {
+		clusters = append(clusters, text[start:])
+	}
+	return clusters
+}
+
+// GraphemeCount return the number of user perceived characters of a text.
+func GraphemeCount(text string) int {
+	if !utf8.ValidString(text) {
+		return utf8.RuneCountInString(text)
+	}
+	return len(Graphemes(text))
+}
+
+// isBoundary reports whether a cluster ends between prev and r.
+func isBoundary(prev, r rune, indicators int) bool {
+	switch {
+	case prev == '\r' && r == '\n':
+		return false
+	case unicode.IsControl(prev) || unicode.IsControl(r):
+		return true
+	case prev == zwj || isExtend(r):
+		return false
+	case isRegionalIndicator(prev) && isRegionalIndicator(r):
+		return indicators%2 == 0
+	}
+	return true
+}
+
+// isExtend reports whether r extends the previous character.
+func isExtend(r rune) bool {
+	switch {
+	case r == zwj:
+		return true
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc):
+		return true
+	case unicode.Is(unicode.Variation_Selector, r):
+		return true
+	// emoji skin tone modifiers.
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		return true
+	// emoji tag sequences.
+	case r >= 0xE0020 && r <= 0xE007F:
+		return true
+	// hangul vowel and trailing consonant jamos.
+	case r >= 0x1160 && r <= 0x11FF, r >= 0xD7B0 && r <= 0xD7FB:
+		return true
+	}
+	return false
+}
+
+// isRegionalIndicator reports whether r is a flag letter.
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
@@ -0,0 +1,152 @@
+// Package tweettext validates tweet texts and computes their weighted
+// length, it is shared by the tweet service and its clients.
+package tweettext
+
+import (
+	"errors"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/idirall22/twee/entity"
+	"github.com/idirall22/twee/pb"
+)
+
+var (
+	// ErrInvalidUTF8 returned when a text is not valid UTF-8.
+	ErrInvalidUTF8 = errors.New("Text is not valid UTF-8")
+	// ErrControlCharacter returned when a text contains control characters
+	// other than tabs and line breaks.
+	ErrControlCharacter = errors.New("Text contains control characters")
+	// ErrTooLong returned when a text weighted length is over the limit.
+	ErrTooLong = errors.New("Text is too long")
+)
+
+// WeightRange weight of the characters between Start and End included.
+type WeightRange struct {
+	Start  rune
+	End    rune
+	Weight int
+}
+
+// Config weighted length configuration, a character of weight Scale counts
+// as one character.
+type Config struct {
+	// MaxWeightedLength maximum weighted length of a text.
+	MaxWeightedLength int
+	// Scale weight of one character.
+	Scale int
+	// DefaultWeight weight of characters outside Ranges.
+	DefaultWeight int
+	// Ranges weights of specific characters.
+	Ranges []WeightRange
+	// URLLength length of any url whatever its size.
+	URLLength int
+}
+
+// DefaultConfig latin characters count as one character, other scripts
+// and emoji as two, urls as 23.
+var DefaultConfig = &Config{
+	MaxWeightedLength: 280,
+	Scale:             100,
+	DefaultWeight:     200,
+	Ranges: []WeightRange{
+		{Start: 0x0000, End: 0x10FF, Weight: 100},
+		{Start: 0x2000, End: 0x200D, Weight: 100},
+		{Start: 0x2010, End: 0x201F, Weight: 100},
+		{Start: 0x2032, End: 0x2037, Weight: 100},
+	},
+	URLLength: 23,
+}
+
+// Result a parsed text.
+type Result struct {
+	// Text NFC normalized text.
+	Text string
+	// WeightedLength length of the normalized text.
+	WeightedLength int
+	// Valid reports whether the length is within the limit.
+	Valid bool
+}
+
+// Normalize return the NFC form of a text.
+func Normalize(text string) string {
+	return norm.NFC.String(text)
+}
+
+// Parse check a text and compute the weighted length of its normalized
+// form, an error is returned when the text can not be normalized.
+func (c *Config) Parse(text string) (*Result, error) {
+	if !utf8.ValidString(text) {
+		return nil, ErrInvalidUTF8
+	}
+	for _, r := range text {
+		if isForbiddenControl(r) {
+			return nil, ErrControlCharacter
+		}
+	}
+
+	normalized := Normalize(text)
+	length := c.WeightedLength(normalized)
+	return &Result{
+		Text:           normalized,
+		WeightedLength: length,
+		Valid:          length <= c.MaxWeightedLength,
+	}, nil
+}
+
+// Validate return the normalized text, ErrTooLong when it is over the limit.
+func (c *Config) Validate(text string) (string, error) {
+	res, err := c.Parse(text)
+	if err != nil {
+		return "", err
+	}
+	if !res.Valid {
+		return "", ErrTooLong
+	}
+	return res.Text, nil
+}
+
+// WeightedLength return the weighted length of a text, each grapheme
+// cluster weights as its first character and urls count as URLLength.
+func (c *Config) WeightedLength(text string) int {
+	weight, last := 0, 0
+	for _, e := range entity.Parse(text) {
+		if e.Type != pb.EntityType_ENTITY_URL {
+			continue
+		}
+		weight += c.weight(text[last:e.Start]) + c.URLLength*c.Scale
+		last = int(e.End)
+	}
+	weight += c.weight(text[last:])
+
+	// a partial character counts as a full one.
+	return (weight + c.Scale - 1) / c.Scale
+}
+
+// weight return the weight of a text without urls.
+func (c *Config) weight(text string) int {
+	weight := 0
+	for _, g := range Graphemes(text) {
+		r, _ := utf8.DecodeRuneInString(g)
+		weight += c.runeWeight(r)
+	}
+	return weight
+}
+
+// runeWeight return the weight of a character.
+func (c *Config) runeWeight(r rune) int {
+	for _, wr := range c.Ranges {
+		if r >= wr.Start && r <= wr.End {
+			return wr.Weight
+		}
+	}
+	return c.DefaultWeight
+}
+
+// isForbiddenControl reports whether r is a control character other than
+// tabs and line breaks.
+func isForbiddenControl(r rune) bool {
+	return unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r'
+}
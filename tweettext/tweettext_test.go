@@ -0,0 +1,68 @@
+package tweettext_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/tweettext"
+)
+
+func TestGraphemes(t *testing.T) {
+	cases := []struct {
+		text     string
+		expected []string
+	}{
+		{"abc", []string{"a", "b", "c"}},
+		{"été", []string{"é", "t", "é"}},
+		{"a\r\nb", []string{"a", "\r\n", "b"}},
+		{"👍🏽!", []string{"👍🏽", "!"}},
+		{"👩‍💻 ", []string{"👩‍💻", " "}},
+		{"🇫🇷🇩🇪🇮", []string{"🇫🇷", "🇩🇪", "🇮"}},
+		{"❤️", []string{"❤️"}},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.expected, tweettext.Graphemes(c.text), c.text)
+		require.Equal(t, len(c.expected), tweettext.GraphemeCount(c.text), c.text)
+	}
+}
+
+func TestParse(t *testing.T) {
+	config := tweettext.DefaultConfig
+
+	// combining accents are composed.
+	res, err := config.Parse("cafe\u0301")
+	require.NoError(t, err)
+	require.Equal(t, "café", res.Text)
+	require.Equal(t, 4, res.WeightedLength)
+	require.True(t, res.Valid)
+
+	// urls count as 23 characters whatever their length.
+	res, err = config.Parse("see https://twee.io/" + strings.Repeat("a", 100))
+	require.NoError(t, err)
+	require.Equal(t, 4+23, res.WeightedLength)
+
+	// emoji and CJK characters count twice.
+	res, err = config.Parse("👩‍💻日本")
+	require.NoError(t, err)
+	require.Equal(t, 6, res.WeightedLength)
+
+	res, err = config.Parse(strings.Repeat("a", 281))
+	require.NoError(t, err)
+	require.False(t, res.Valid)
+
+	_, err = config.Validate(strings.Repeat("日", 141))
+	require.Equal(t, tweettext.ErrTooLong, err)
+
+	_, err = config.Parse("a\x00b")
+	require.Equal(t, tweettext.ErrControlCharacter, err)
+
+	_, err = config.Parse("a\xffb")
+	require.Equal(t, tweettext.ErrInvalidUTF8, err)
+
+	text, err := config.Validate("line\n\ttab")
+	require.NoError(t, err)
+	require.Equal(t, "line\n\ttab", text)
+}
@@ -0,0 +1,19 @@
+package eventstore
+
+import (
+	"github.com/idirall22/twee/pb"
+)
+
+// EventStore interface
+type EventStore interface {
+	// Start event store.
+	Start() error
+	// SubscribeTweets subscribe to tweet events on every instance, only
+	// events published after the call are received.
+	SubscribeTweets() (<-chan *pb.TweetEvent, error)
+	// SubscribeFollows subscribe to follow events on every instance, only
+	// events published after the call are received.
+	SubscribeFollows() (<-chan *pb.FollowEvent, error)
+	// Close event store connection.
+	Close() error
+}
@@ -0,0 +1,97 @@
+package ueventstore
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/nats-io/stan.go"
+
+	"github.com/idirall22/twee/common"
+	"github.com/idirall22/twee/pb"
+)
+
+// NatsStreamingEventStore event store.
+type NatsStreamingEventStore struct {
+	cc            stan.Conn
+	tweetSubject  string
+	followSubject string
+	done          chan struct{}
+}
+
+// NewNatsStreamingEventStore create new stan event store.
+func NewNatsStreamingEventStore(tweetSubject, followSubject, clusterID, clientID string,
+	option ...stan.Option) (*NatsStreamingEventStore, error) {
+
+	sc, err := stan.Connect(clusterID, clientID, option...)
+	if err != nil {
+		return nil, fmt.Errorf("Could not connect to nats streaming server: %v", err)
+	}
+
+	return &NatsStreamingEventStore{
+		cc:            sc,
+		tweetSubject:  tweetSubject,
+		followSubject: followSubject,
+		done:          make(chan struct{}),
+	}, nil
+}
+
+// Start event store, it waits for the store to be closed.
+func (e *NatsStreamingEventStore) Start() error {
+	<-e.done
+	return nil
+}
+
+// SubscribeTweets subscribe to tweet events.
+func (e *NatsStreamingEventStore) SubscribeTweets() (<-chan *pb.TweetEvent, error) {
+	events := make(chan *pb.TweetEvent, 128)
+
+	err := e.subscribe(e.tweetSubject, func() proto.Message {
+		return &pb.TweetEvent{}
+	}, func(m proto.Message) {
+		events <- m.(*pb.TweetEvent)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// SubscribeFollows subscribe to follow events.
+func (e *NatsStreamingEventStore) SubscribeFollows() (<-chan *pb.FollowEvent, error) {
+	events := make(chan *pb.FollowEvent, 128)
+
+	err := e.subscribe(e.followSubject, func() proto.Message {
+		return &pb.FollowEvent{}
+	}, func(m proto.Message) {
+		events <- m.(*pb.FollowEvent)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// subscribe to a subject, every instance receives the events to evict
+// its own cache.
+func (e *NatsStreamingEventStore) subscribe(subject string, new func() proto.Message, found func(m proto.Message)) error {
+	_, err := e.cc.Subscribe(subject, func(msg *stan.Msg) {
+		m := new()
+		err := common.JSONToProtobufMessage(string(msg.Data), m)
+		if err != nil {
+			log.Printf("Could not parse %s event: %v", subject, err)
+			return
+		}
+		found(m)
+	})
+	if err != nil {
+		return fmt.Errorf("Could not subscribe to %s: %v", subject, err)
+	}
+	return nil
+}
+
+// Close event store connection.
+func (e *NatsStreamingEventStore) Close() error {
+	close(e.done)
+	return e.cc.Close()
+}
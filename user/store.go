@@ -10,6 +10,6 @@ import (
 type Store interface {
 	// List users profile
 	List(ctx context.Context, limit, offset int32, found func(user *pb.User) error) error
-	// Get user profile by username
-	Profile(ctx context.Context, username string) (*pb.User, error)
+	// Get user profile by username as seen by the viewer, 0 when anonymous
+	Profile(ctx context.Context, viewerID int64, username string) (*pb.User, error)
 }
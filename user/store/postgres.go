@@ -4,10 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/ptypes"
 
 	"github.com/idirall22/twee/common"
 	option "github.com/idirall22/twee/options"
 	"github.com/idirall22/twee/pb"
+	"github.com/idirall22/twee/utils"
 )
 
 // PostgresUserStore struct
@@ -78,8 +82,9 @@ func (s *PostgresUserStore) List(ctx context.Context, limit, offset int32, found
 	return nil
 }
 
-// Profile Get user profile by username
-func (s *PostgresUserStore) Profile(ctx context.Context, username string) (*pb.User, error) {
+// Profile Get user profile by username, the pinned tweet is set when the
+// viewer can see it.
+func (s *PostgresUserStore) Profile(ctx context.Context, viewerID int64, username string) (*pb.User, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("Could not start transaction: %v", err)
@@ -87,8 +92,12 @@ func (s *PostgresUserStore) Profile(ctx context.Context, username string) (*pb.U
 	defer tx.Rollback()
 
 	stmt, err := tx.PrepareContext(ctx, `
-		SELECT id, username, followee_count, follower_count
-		FROM users LIMIT 1
+		SELECT u.id, u.username, u.followee_count, u.follower_count,
+			t.id, t.content, t.created_at, t.retweet_count, t.like_count, t.visibility
+		FROM users u
+		LEFT JOIN tweets t ON t.id = u.pinned_tweet_id AND t.deleted_at IS NULL
+			AND `+common.TweetVisibleTo("t", "$2")+`
+		WHERE u.username=$1
 	`)
 
 	if err != nil {
@@ -96,17 +105,43 @@ func (s *PostgresUserStore) Profile(ctx context.Context, username string) (*pb.U
 	}
 
 	user := &pb.User{}
-	err = stmt.QueryRowContext(ctx).Scan(
+	var tID sql.NullInt64
+	var tContent, tVisibility sql.NullString
+	var tCreatedAt sql.NullTime
+	var tRetweetCount, tLikeCount sql.NullInt64
+	err = stmt.QueryRowContext(ctx, username, viewerID).Scan(
 		&user.Id,
 		&user.Username,
 		&user.FolloweeCount,
 		&user.FollowerCount,
+		&tID,
+		&tContent,
+		&tCreatedAt,
+		&tRetweetCount,
+		&tLikeCount,
+		&tVisibility,
 	)
 
+	if err == sql.ErrNoRows {
+		return nil, utils.ErrNotExists
+	}
 	if err != nil {
 		return nil, fmt.Errorf("Could not query: %v", err)
 	}
 
+	if tID.Valid {
+		user.PinnedTweet = &pb.Tweet{
+			Id:           tID.Int64,
+			UserId:       strconv.FormatInt(user.Id, 10),
+			Content:      tContent.String,
+			RetweetCount: uint32(tRetweetCount.Int64),
+			LikeCount:    uint32(tLikeCount.Int64),
+			Visibility:   pb.Visibility(pb.Visibility_value[tVisibility.String]),
+			Pinned:       true,
+		}
+		user.PinnedTweet.CreatedAt, _ = ptypes.TimestampProto(tCreatedAt.Time)
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, fmt.Errorf("Could not commit transaction: %v", err)
@@ -3,31 +3,88 @@ package user
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/cache"
+	memorycache "github.com/idirall22/twee/cache/memory"
 	"github.com/idirall22/twee/pb"
+	eventstore "github.com/idirall22/twee/user/event_store"
 	ustore "github.com/idirall22/twee/user/store"
+	"github.com/idirall22/twee/utils"
 
 	option "github.com/idirall22/twee/options"
 )
 
+const (
+	// profileCacheSize maximum number of cached profiles.
+	profileCacheSize = 10000
+	// profileCacheTTL duration a profile is cached, events evict it before
+	// when an event store is set.
+	profileCacheTTL = time.Minute
+)
+
 // Server user service server
 type Server struct {
-	userStore Store
+	userStore  Store
+	eventStore eventstore.EventStore
+	cache      cache.Cache
+	done       chan struct{}
 }
 
-// NewUserServer create user server service
-func NewUserServer(opts *option.PostgresOptions) (*Server, error) {
+// NewUserServer create user server service, cached profiles are evicted by
+// the events of es when it is set.
+func NewUserServer(opts *option.PostgresOptions, es eventstore.EventStore) (*Server, error) {
 	uStore, err := ustore.NewPostgresUserStore(opts)
 
 	if err != nil {
 		return nil, fmt.Errorf("Could not Start store: %v", err)
 	}
-	return &Server{
-		userStore: uStore,
-	}, nil
+	return newServer(uStore, es)
+}
+
+func newServer(s Store, es eventstore.EventStore) (*Server, error) {
+	server := &Server{
+		userStore:  s,
+		eventStore: es,
+		cache:      memorycache.NewMemoryCache(profileCacheSize, profileCacheTTL),
+		done:       make(chan struct{}),
+	}
+
+	if es != nil {
+		go es.Start()
+
+		tweets, err := es.SubscribeTweets()
+		if err != nil {
+			return nil, fmt.Errorf("Could not subscribe to tweet events: %v", err)
+		}
+
+		follows, err := es.SubscribeFollows()
+		if err != nil {
+			return nil, fmt.Errorf("Could not subscribe to follow events: %v", err)
+		}
+		go cache.Watch(server.cache, tweets, follows, server.done)
+	}
+
+	return server, nil
+}
+
+// Close stop the cache watcher and the event store.
+func (s *Server) Close() error {
+	close(s.done)
+	if s.eventStore != nil {
+		return s.eventStore.Close()
+	}
+	return nil
+}
+
+// CacheStats return the profiles cache statistics.
+func (s *Server) CacheStats() cache.Stats {
+	return s.cache.Stats()
 }
 
 // List users
@@ -59,10 +116,26 @@ func (s *Server) Profile(ctx context.Context, req *pb.RequestUserProfile) (*pb.R
 		return nil, status.Errorf(codes.InvalidArgument, "Username should not be empty")
 	}
 
-	user, err := s.userStore.Profile(ctx, req.GetUsername())
+	// anonymous viewers only see public pinned tweets.
+	var viewerID int64
+	if userInfos, err := auth.GetUserInfosFromContext(ctx); err == nil {
+		viewerID = userInfos.ID
+	}
+
+	// the pinned tweet visibility depends on the viewer.
+	key := "profile:" + req.GetUsername() + ":" + strconv.FormatInt(viewerID, 10)
+	if v, ok := s.cache.Get(key); ok {
+		return &pb.ResposneUser{User: v.(*pb.User)}, nil
+	}
+
+	user, err := s.userStore.Profile(ctx, viewerID, req.GetUsername())
+	if err == utils.ErrNotExists {
+		return nil, status.Errorf(codes.NotFound, "User not exists")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Error to fetch user profile: %v", err)
 	}
 
+	s.cache.Set(key, user, cache.UserTag(user.Id))
 	return &pb.ResposneUser{User: user}, nil
 }
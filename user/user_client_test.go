@@ -10,6 +10,7 @@ import (
 	sample "github.com/idirall22/twee/generator"
 
 	"github.com/idirall22/twee/auth"
+	"github.com/idirall22/twee/common"
 
 	option "github.com/idirall22/twee/options"
 	"github.com/idirall22/twee/user"
@@ -64,7 +65,11 @@ func TestUserService(t *testing.T) {
 	res, err := userClient.Profile(ctx, &pb.RequestUserProfile{Username: username})
 	require.NoError(t, err)
 	require.NotNil(t, res)
-	require.NotEmpty(t, res.User.Username)
+	require.Equal(t, username, res.User.Username)
+	require.Nil(t, res.User.PinnedTweet)
+
+	_, err = userClient.Profile(ctx, &pb.RequestUserProfile{Username: "unknown-" + username})
+	require.Error(t, err)
 }
 
 // start auth server
@@ -79,7 +84,7 @@ func startUserTestServer(t *testing.T) string {
 		time.Second,
 	)
 
-	server, err := user.NewUserServer(opts)
+	server, err := user.NewUserServer(opts, nil)
 	require.NoError(t, err)
 	require.NotNil(t, server)
 
@@ -103,7 +108,7 @@ func startUserClient(t *testing.T, address string) pb.UserServiceClient {
 
 // start auth server
 func startAuthTestServer(t *testing.T, jwtManager *auth.JwtManager) string {
-	server, err := auth.NewAuthServer(jwtManager)
+	server, err := auth.NewAuthServer(jwtManager, common.PostgresTestOptions)
 	require.NoError(t, err)
 	require.NotNil(t, server)
 
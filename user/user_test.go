@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/idirall22/twee/pb"
+)
+
+// countingStore return the same profile and count the store lookups.
+type countingStore struct {
+	mu       sync.Mutex
+	profiles int
+}
+
+func (s *countingStore) List(ctx context.Context, limit, offset int32, found func(user *pb.User) error) error {
+	return nil
+}
+
+func (s *countingStore) Profile(ctx context.Context, viewerID int64, username string) (*pb.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.profiles++
+	return &pb.User{Id: 1, Username: username}, nil
+}
+
+func (s *countingStore) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.profiles
+}
+
+// chanEventStore event store fed by the test.
+type chanEventStore struct {
+	tweets  chan *pb.TweetEvent
+	follows chan *pb.FollowEvent
+}
+
+func (e *chanEventStore) Start() error { return nil }
+func (e *chanEventStore) SubscribeTweets() (<-chan *pb.TweetEvent, error) {
+	return e.tweets, nil
+}
+func (e *chanEventStore) SubscribeFollows() (<-chan *pb.FollowEvent, error) {
+	return e.follows, nil
+}
+func (e *chanEventStore) Close() error { return nil }
+
+func TestProfileCacheEviction(t *testing.T) {
+	store := &countingStore{}
+	es := &chanEventStore{
+		tweets:  make(chan *pb.TweetEvent),
+		follows: make(chan *pb.FollowEvent),
+	}
+	server, err := newServer(store, es)
+	require.NoError(t, err)
+	defer server.Close()
+
+	profile := func() {
+		res, err := server.Profile(context.Background(), &pb.RequestUserProfile{Username: "alice"})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), res.User.Id)
+	}
+	// evicted wait for the next lookup to reach the store.
+	evicted := func(lookups int) {
+		require.Eventually(t, func() bool {
+			profile()
+			return store.count() == lookups
+		}, time.Second, time.Millisecond*10)
+	}
+
+	profile()
+	profile()
+	require.Equal(t, 1, store.count())
+
+	// a new follower changes the counts.
+	es.follows <- &pb.FollowEvent{Action: pb.Action_CREATED, Follower: 2, Followee: 1}
+	evicted(2)
+
+	// a created tweet changes nothing.
+	es.tweets <- &pb.TweetEvent{Action: pb.Action_CREATED, UserId: 1}
+	profile()
+	require.Equal(t, 2, store.count())
+
+	// pinning a tweet changes the pinned tweet.
+	es.tweets <- &pb.TweetEvent{Action: pb.Action_UPDATED, UserId: 1, TweetId: 7}
+	evicted(3)
+}
@@ -6,6 +6,12 @@ var (
 	// ErrNotExists record not exists
 	ErrNotExists = fmt.Errorf("Record not exists")
 
+	// ErrAlreadyExists record already exists
+	ErrAlreadyExists = fmt.Errorf("Record already exists")
+
+	// ErrClosed record is closed
+	ErrClosed = fmt.Errorf("Record closed")
+
 	// ErrInvalidID id is not valid
 	ErrInvalidID = fmt.Errorf("Invalid id")
 